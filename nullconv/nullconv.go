@@ -0,0 +1,165 @@
+// Package nullconv migrates from the github.com/aarondl/null types to the types package.
+//
+// The null types have no undefined state, so all converted values are defined,
+// an invalid null value is converted to a nil value.
+package nullconv
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/aarondl/null/v8"
+	"github.com/friendsofgo/errors"
+
+	types "github.com/meitner-se/go-types"
+)
+
+// FromNull converts any null value to a types.Nullable,
+// it can be used for the null types that have no corresponding type in the types package.
+func FromNull[T any](underlying T, valid bool) types.Nullable[T] {
+	if !valid {
+		return types.NewNullableFromPtr[T](nil)
+	}
+
+	return types.NewNullable(underlying)
+}
+
+// FromNullBool converts a null.Bool to a types.Bool.
+func FromNullBool(n null.Bool) types.Bool {
+	return types.NewBoolFromPtr(n.Ptr())
+}
+
+// FromNullFloat64 converts a null.Float64 to a types.Float64.
+func FromNullFloat64(n null.Float64) types.Float64 {
+	return types.NewFloat64FromPtr(n.Ptr())
+}
+
+// FromNullInt converts a null.Int to a types.Int.
+func FromNullInt(n null.Int) types.Int {
+	return types.NewIntFromPtr(n.Ptr())
+}
+
+// FromNullInt16 converts a null.Int16 to a types.Int16.
+func FromNullInt16(n null.Int16) types.Int16 {
+	return types.NewInt16FromPtr(n.Ptr())
+}
+
+// FromNullInt64 converts a null.Int64 to a types.Int64.
+func FromNullInt64(n null.Int64) types.Int64 {
+	return types.NewInt64FromPtr(n.Ptr())
+}
+
+// FromNullJSON converts a null.JSON to a types.JSON.
+func FromNullJSON(n null.JSON) types.JSON {
+	if !n.Valid {
+		return types.NewJSONFromPtr(nil)
+	}
+
+	return types.NewJSON(json.RawMessage(n.JSON))
+}
+
+// FromNullString converts a null.String to a types.String.
+func FromNullString(n null.String) types.String {
+	return types.NewStringFromPtr(n.Ptr())
+}
+
+// FromNullStringToRichText converts a null.String to a types.RichText.
+func FromNullStringToRichText(n null.String) types.RichText {
+	return types.NewRichTextFromPtr(n.Ptr())
+}
+
+// FromNullTime converts a null.Time to a types.Timestamp.
+func FromNullTime(n null.Time) types.Timestamp {
+	return types.NewTimestampFromPtr(n.Ptr())
+}
+
+// FromNullTimeToDate converts a null.Time to a types.Date.
+func FromNullTimeToDate(n null.Time) types.Date {
+	return types.NewDateFromPtr(n.Ptr())
+}
+
+// FromNullTimeToTime converts a null.Time to a types.Time.
+func FromNullTimeToTime(n null.Time) types.Time {
+	return types.NewTimeFromPtr(n.Ptr())
+}
+
+type conversion struct {
+	from reflect.Type
+	to   reflect.Type
+}
+
+// conversions contains the converters used by ConvertStruct, keyed by the source and destination type.
+var conversions = map[conversion]func(v reflect.Value) reflect.Value{}
+
+func registerConversion[From, To any](fn func(From) To) {
+	key := conversion{
+		from: reflect.TypeOf((*From)(nil)).Elem(),
+		to:   reflect.TypeOf((*To)(nil)).Elem(),
+	}
+
+	conversions[key] = func(v reflect.Value) reflect.Value {
+		return reflect.ValueOf(fn(v.Interface().(From)))
+	}
+}
+
+func init() {
+	registerConversion(FromNullBool)
+	registerConversion(FromNullFloat64)
+	registerConversion(FromNullInt)
+	registerConversion(FromNullInt16)
+	registerConversion(FromNullInt64)
+	registerConversion(FromNullJSON)
+	registerConversion(FromNullString)
+	registerConversion(FromNullStringToRichText)
+	registerConversion(FromNullTime)
+	registerConversion(FromNullTimeToDate)
+	registerConversion(FromNullTimeToTime)
+}
+
+// ConvertStruct copies the exported fields of the src struct to the fields with the same name in the dst struct,
+// converting the null types to the corresponding types in the types package.
+//
+// The dst must be a pointer to a struct, fields that only exist in one of the structs are ignored
+// and fields with the same type are copied as is. An error is returned if a field cannot be converted.
+func ConvertStruct(dst, src interface{}) error {
+	dstValue := reflect.ValueOf(dst)
+	if dstValue.Kind() != reflect.Pointer || dstValue.IsNil() || dstValue.Elem().Kind() != reflect.Struct {
+		return errors.New(fmt.Sprintf("dst must be a non-nil pointer to a struct, got %T", dst))
+	}
+
+	srcValue := reflect.Indirect(reflect.ValueOf(src))
+	if srcValue.Kind() != reflect.Struct {
+		return errors.New(fmt.Sprintf("src must be a struct or a pointer to a struct, got %T", src))
+	}
+
+	dstValue = dstValue.Elem()
+
+	for i := 0; i < srcValue.NumField(); i++ {
+		srcField := srcValue.Type().Field(i)
+		if !srcField.IsExported() {
+			continue
+		}
+
+		dstField := dstValue.FieldByName(srcField.Name)
+		if !dstField.IsValid() || !dstField.CanSet() {
+			continue
+		}
+
+		v := srcValue.Field(i)
+
+		if convert, ok := conversions[conversion{from: v.Type(), to: dstField.Type()}]; ok {
+			dstField.Set(convert(v))
+			continue
+		}
+
+		if v.Type().AssignableTo(dstField.Type()) {
+			dstField.Set(v)
+			continue
+		}
+
+		return errors.New(fmt.Sprintf("cannot convert field %s from %s to %s", srcField.Name, v.Type(), dstField.Type()))
+	}
+
+	return nil
+}
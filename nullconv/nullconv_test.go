@@ -0,0 +1,99 @@
+package nullconv
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	types "github.com/meitner-se/go-types"
+)
+
+func TestFromNull(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		assert.Equal(t, types.NewBool(true), FromNullBool(null.BoolFrom(true)))
+		assert.Equal(t, types.NewFloat64(1.5), FromNullFloat64(null.Float64From(1.5)))
+		assert.Equal(t, types.NewInt(1), FromNullInt(null.IntFrom(1)))
+		assert.Equal(t, types.NewInt16(2), FromNullInt16(null.Int16From(2)))
+		assert.Equal(t, types.NewInt64(3), FromNullInt64(null.Int64From(3)))
+		assert.Equal(t, types.NewJSON(json.RawMessage(`{"a":1}`)), FromNullJSON(null.JSONFrom([]byte(`{"a":1}`))))
+		assert.Equal(t, types.NewString("hello"), FromNullString(null.StringFrom("hello")))
+		assert.Equal(t, types.NewNullable(uint8(4)), FromNull(uint8(4), true))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		assert.Equal(t, types.NewBoolFromPtr(nil), FromNullBool(null.Bool{}))
+		assert.Equal(t, types.NewFloat64FromPtr(nil), FromNullFloat64(null.Float64{}))
+		assert.Equal(t, types.NewIntFromPtr(nil), FromNullInt(null.Int{}))
+		assert.Equal(t, types.NewInt16FromPtr(nil), FromNullInt16(null.Int16{}))
+		assert.Equal(t, types.NewInt64FromPtr(nil), FromNullInt64(null.Int64{}))
+		assert.Equal(t, types.NewJSONFromPtr(nil), FromNullJSON(null.JSON{}))
+		assert.Equal(t, types.NewStringFromPtr(nil), FromNullString(null.String{}))
+		assert.Equal(t, types.NewTimestampFromPtr(nil), FromNullTime(null.Time{}))
+		assert.Equal(t, types.NewNullableFromPtr[uint8](nil), FromNull(uint8(4), false))
+	})
+}
+
+func TestConvertStruct(t *testing.T) {
+	type legacy struct {
+		ID        int
+		Name      null.String
+		Bio       null.String
+		Age       null.Int
+		BirthDate null.Time
+		CreatedAt null.Time
+		Removed   null.String
+		internal  string
+	}
+
+	type model struct {
+		ID        int
+		Name      types.String
+		Bio       types.RichText
+		Age       types.Int
+		BirthDate types.Date
+		CreatedAt types.Timestamp
+		Extra     types.String
+		internal  string
+	}
+
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("converts fields", func(t *testing.T) {
+		var m model
+		err := ConvertStruct(&m, legacy{
+			ID:        7,
+			Name:      null.StringFrom("Alice"),
+			Bio:       null.String{},
+			Age:       null.IntFrom(30),
+			BirthDate: null.TimeFrom(time.Date(1994, 2, 3, 0, 0, 0, 0, time.UTC)),
+			CreatedAt: null.TimeFrom(createdAt),
+			internal:  "ignored",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 7, m.ID)
+		assert.Equal(t, types.NewString("Alice"), m.Name)
+		assert.True(t, m.Bio.IsDefined())
+		assert.True(t, m.Bio.IsNil())
+		assert.Equal(t, types.NewInt(30), m.Age)
+		assert.Equal(t, "1994-02-03", m.BirthDate.String())
+		assert.Equal(t, types.NewTimestamp(createdAt), m.CreatedAt)
+		assert.False(t, m.Extra.IsDefined())
+		assert.Empty(t, m.internal)
+	})
+
+	t.Run("incompatible field", func(t *testing.T) {
+		var m struct{ Age types.String }
+		assert.Error(t, ConvertStruct(&m, legacy{Age: null.IntFrom(1)}))
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		var m model
+		assert.Error(t, ConvertStruct(m, legacy{}))
+		assert.Error(t, ConvertStruct(&m, "legacy"))
+	})
+}
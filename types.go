@@ -2,19 +2,46 @@ package types
 
 import (
 	"bytes"
+	"cmp"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
 	"database/sql/driver"
+	"encoding"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"io"
+	"math"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+	"gopkg.in/yaml.v3"
 
 	"github.com/aarondl/null/v8/convert"
 	"github.com/friendsofgo/errors"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
 )
 
 var (
@@ -24,19 +51,153 @@ var (
 
 var nullBytes = []byte("null")
 
+// clock is the function used by Now, see SetNow.
+var clock = newSetting(time.Now)
+
+// Now returns the current time and is used by the functions that compare against the current time,
+// the clock can be replaced with SetNow, e.g. to freeze it in tests.
+func Now() time.Time {
+	return clock.get()()
+}
+
+// SetNow replaces the clock used by Now and a nil now restores time.Now.
+// It is meant for tests and startup, but it is safe for concurrent use.
+func SetNow(now func() time.Time) {
+	if now == nil {
+		now = time.Now
+	}
+
+	clock.set(now)
+}
+
 func isNullBytes(d []byte) bool {
 	return string(d) == string(nullBytes)
 }
 
+// setting is a package-level setting, such as the decimal separator of Float64,
+// which can be changed with its Set function while it is read concurrently.
+type setting[T any] struct {
+	value atomic.Pointer[T]
+}
+
+func newSetting[T any](value T) *setting[T] {
+	s := &setting[T]{}
+	s.set(value)
+
+	return s
+}
+
+func (s *setting[T]) get() T {
+	return *s.value.Load()
+}
+
+func (s *setting[T]) set(value T) {
+	s.value.Store(&value)
+}
+
+// parsers contains the types registered with RegisterType, guarded by parsersMu.
+var (
+	parsers   = map[string]func(value string) (any, error){}
+	parsersMu sync.RWMutex
+)
+
+// RegisterType registers a parser for a custom type, which makes ParseFromString
+// (and TypedNull) support the type, e.g. RegisterType("types.OrgNumber", parseOrgNumber).
+//
+// The registered parsers are consulted before the types in this package, using the exact name.
+// RegisterType is meant to be called at init time, but it is safe for concurrent use with ParseFromString.
+// It panics if the name is already registered or the parser is nil.
+func RegisterType(name string, parser func(value string) (any, error)) {
+	if parser == nil {
+		panic("types: RegisterType parser is nil")
+	}
+
+	parsersMu.Lock()
+	defer parsersMu.Unlock()
+
+	if _, ok := parsers[name]; ok {
+		panic("types: RegisterType called twice for type " + name)
+	}
+
+	parsers[name] = parser
+}
+
+// parseWrapped parses the value with the parse function and wraps the result, e.g. a Timestamp in a TimestampLocal.
+func parseWrapped[T, W any](value string, parse func(str string) (T, error), wrap func(T) W) (any, error) {
+	v, err := parse(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrap(v), nil
+}
+
+// timestampFromUnixString parses the number of seconds since the Unix epoch, an empty string is nil.
+func timestampFromUnixString(str string) (Timestamp, error) {
+	if str == "" {
+		return NewTimestampFromPtr(nil), nil
+	}
+
+	sec, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return Timestamp{}, errors.Wrap(err, "invalid unix timestamp: "+str)
+	}
+
+	return NewTimestampFromUnix(sec), nil
+}
+
+// fromStrings parses the strings with the parse function,
+// the error of the first string that cannot be parsed is returned.
+func fromStrings[T any](strs []string, parse func(str string) (T, error)) ([]T, error) {
+	values := make([]T, len(strs))
+	for i := range strs {
+		v, err := parse(strs[i])
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("cannot parse element %d", i))
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+// toStrings converts the values to their string representation, a nil value becomes an empty string.
+func toStrings[T fmt.Stringer](values []T) []string {
+	strs := make([]string, len(values))
+	for i := range values {
+		strs[i] = values[i].String()
+	}
+	return strs
+}
+
 func ParseFromString(typeAsString, value string) (any, error) {
+	parsersMu.RLock()
+	parser, ok := parsers[typeAsString]
+	parsersMu.RUnlock()
+
+	if ok {
+		return parser(value)
+	}
+
 	switch strings.TrimPrefix(typeAsString, "types.") {
 
 	case "Bool":
 		return BoolFromString(value)
 
+	case "BoolText":
+		return parseWrapped(value, BoolFromString, NewBoolTextMode)
+
+	case "Bytes":
+		return BytesFromString(value)
+
+	case "Color":
+		return ColorFromString(value)
+
 	case "Date":
 		return DateFromString(value)
 
+	case "DateTime":
+		return DateTimeFromString(value)
+
 	case "Float64":
 		return Float64FromString(value)
 
@@ -52,6 +213,12 @@ func ParseFromString(typeAsString, value string) (any, error) {
 	case "JSON":
 		return JSONFromString(value)
 
+	case "Money":
+		return MoneyFromString(value)
+
+	case "Percent":
+		return PercentFromString(value)
+
 	case "RichText":
 		return RichTextFromString(value)
 
@@ -61,9 +228,23 @@ func ParseFromString(typeAsString, value string) (any, error) {
 	case "Time":
 		return TimeFromString(value)
 
+	case "TimeSeconds":
+		return TimeSecondsFromString(value)
+
 	case "Timestamp":
 		return TimestampFromString(value)
 
+	case "TimestampLocal":
+		return parseWrapped(value, TimestampFromString, NewTimestampLocal)
+
+	case "TimestampMinute":
+		return parseWrapped(value, TimestampFromString, func(s Timestamp) TimestampMinute {
+			return NewTimestampMinuteMode(s.TruncateToMinute())
+		})
+
+	case "UnixTimestamp":
+		return parseWrapped(value, timestampFromUnixString, NewUnixTimestamp)
+
 	case "UUID":
 		return UUIDFromString(value)
 
@@ -72,15 +253,47 @@ func ParseFromString(typeAsString, value string) (any, error) {
 	}
 }
 
+// TypedNull returns a nil value of the given type, which can be used as a query argument.
+//
+// Every type returns (nil, nil) from Value when it is nil, which is enough for drivers
+// that bind NULL without a type, such as pgx and lib/pq where the server infers the type of the parameter.
+// Drivers that need the type of a NULL parameter receive the typed value before it is converted,
+// through the driver NamedValueChecker interface, so passing the value returned by TypedNull
+// instead of an untyped nil lets them bind the correct NULL type.
+//
+// See https://pkg.go.dev/database/sql/driver#NamedValueChecker
+func TypedNull(typeAsString string) (driver.Valuer, error) {
+	value, err := ParseFromString(typeAsString, "")
+	if err != nil {
+		return nil, err
+	}
+
+	valuer, ok := value.(driver.Valuer)
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("type does not implement driver.Valuer: %s", typeAsString))
+	}
+
+	return valuer, nil
+}
+
 func IsEmptyArray(a any) bool {
 	switch a.(type) {
 
 	case []Bool:
 		return len(a.([]Bool)) == 0
 
+	case []Bytes:
+		return len(a.([]Bytes)) == 0
+
+	case []Color:
+		return len(a.([]Color)) == 0
+
 	case []Date:
 		return len(a.([]Date)) == 0
 
+	case []DateTime:
+		return len(a.([]DateTime)) == 0
+
 	case []Float64:
 		return len(a.([]Float64)) == 0
 
@@ -96,6 +309,12 @@ func IsEmptyArray(a any) bool {
 	case []JSON:
 		return len(a.([]JSON)) == 0
 
+	case []Money:
+		return len(a.([]Money)) == 0
+
+	case []Percent:
+		return len(a.([]Percent)) == 0
+
 	case []RichText:
 		return len(a.([]RichText)) == 0
 
@@ -105,6 +324,9 @@ func IsEmptyArray(a any) bool {
 	case []Time:
 		return len(a.([]Time)) == 0
 
+	case []TimeSeconds:
+		return len(a.([]TimeSeconds)) == 0
+
 	case []Timestamp:
 		return len(a.([]Timestamp)) == 0
 
@@ -116,671 +338,1322 @@ func IsEmptyArray(a any) bool {
 	}
 }
 
-// Bool is used to represent booleans
-type Bool struct {
-	underlying bool
-	isDefined  bool
-	isNil      bool
-}
+// The states used by MarshalBinary and UnmarshalBinary.
+const (
+	binaryUndefined byte = iota
+	binaryNil
+	binaryDefined
+)
 
-// NewBool creates a new Bool object.
-func NewBool(underlying bool) Bool {
-	return Bool{
-		underlying: underlying,
-		isDefined:  true,
-		isNil:      false,
+func marshalBinaryNil(isDefined bool) []byte {
+	if !isDefined {
+		return []byte{binaryUndefined}
 	}
+
+	return []byte{binaryNil}
 }
 
-// NewBoolFromPtr creates a new Bool object from a pointer.
-func NewBoolFromPtr(underlying *bool) Bool {
-	if underlying != nil {
-		return NewBool(*underlying)
+func unmarshalBinaryState(d []byte) (isDefined, isNil bool, payload []byte, err error) {
+	if len(d) == 0 {
+		return false, false, nil, errors.New("invalid binary data: empty")
 	}
 
-	return Bool{
-		isDefined: true,
-		isNil:     true,
+	switch d[0] {
+	case binaryUndefined, binaryNil:
+		if len(d) > 1 {
+			return false, false, nil, errors.New("invalid binary data: unexpected value for nil or undefined")
+		}
+
+		return d[0] == binaryNil, d[0] == binaryNil, nil, nil
+
+	case binaryDefined:
+		return true, false, d[1:], nil
+
+	default:
+		return false, false, nil, errors.New(fmt.Sprintf("invalid binary data: unknown state %d", d[0]))
 	}
 }
 
-// NewBoolUndefined creates a new undefined Bool object.
-func NewBoolUndefined() Bool {
-	return Bool{}
+func checkBinaryLength(payload []byte, length int) error {
+	if len(payload) != length {
+		return errors.New(fmt.Sprintf("invalid binary data: expected %d bytes, got %d", length, len(payload)))
+	}
+
+	return nil
 }
 
-func BoolFromStringPtr(strPtr *string) (Bool, error) {
-	if strPtr == nil {
-		return NewBoolFromPtr(nil), nil
+// msgpackStateExtID is the msgpack extension type used by EncodeMsgpack for nil and undefined values,
+// encoded as a fixext 1 with the same state byte as MarshalBinary. A msgpack nil can't be used for nil values,
+// since the msgpack decoder sets a struct field to its zero value for nil, which is undefined.
+const msgpackStateExtID int8 = 117
+
+func encodeMsgpackNil(enc *msgpack.Encoder, isDefined bool) error {
+	err := enc.EncodeExtHeader(msgpackStateExtID, 1)
+	if err != nil {
+		return err
 	}
 
-	return BoolFromString(*strPtr)
+	_, err = enc.Writer().Write(marshalBinaryNil(isDefined))
+	return err
 }
 
-func BoolFromString(str string) (Bool, error) {
-	if str == "" {
-		return NewBoolFromPtr(nil), nil
+// decodeMsgpackState decodes a nil or undefined value, if the next value is defined nothing is decoded.
+// A msgpack nil is decoded as nil.
+func decodeMsgpackState(dec *msgpack.Decoder) (isDefined, isNil bool, err error) {
+	code, err := dec.PeekCode()
+	if err != nil {
+		return false, false, err
 	}
 
-	underlying, err := strconv.ParseBool(strings.TrimSpace(str))
-	if err != nil {
-		return Bool{}, err
+	switch code {
+	case msgpcode.Nil:
+		return true, true, dec.DecodeNil()
+
+	case msgpcode.FixExt1:
+		extID, _, err := dec.DecodeExtHeader()
+		if err != nil {
+			return false, false, err
+		}
+
+		if extID != msgpackStateExtID {
+			return false, false, errors.New(fmt.Sprintf("invalid msgpack data: unexpected extension type %d", extID))
+		}
+
+		state := make([]byte, 1)
+
+		err = dec.ReadFull(state)
+		if err != nil {
+			return false, false, err
+		}
+
+		isDefined, isNil, _, err := unmarshalBinaryState(state)
+		if err == nil && isDefined && !isNil {
+			err = errors.New("invalid msgpack data: defined state in extension type")
+		}
+
+		return isDefined, isNil, err
+
+	default:
+		return true, false, nil
 	}
+}
 
-	return Bool{
-		underlying: underlying,
-		isDefined:  true,
-		isNil:      false,
-	}, nil
+// NullableValue is implemented by all types in this package, including Nullable,
+// and can be used as a constraint for generic functions over the types.
+type NullableValue interface {
+	IsDefined() bool
+	IsNil() bool
 }
 
-// String output Bool
-func (s Bool) String() string {
-	// If the value is nil we return an empty string
-	if s.IsNil() {
-		return ""
+// nullableValue returns the value of v if it is a type in this package or a pointer to one,
+// a nil pointer is returned as the zero value of the type, which is undefined.
+func nullableValue(v reflect.Value) (NullableValue, bool) {
+	return indirectAs[NullableValue](v)
+}
+
+// indirectAs returns the value of v, or the value v points to, if it implements T.
+// A nil pointer is returned as the zero value of the type it points to.
+func indirectAs[T any](v reflect.Value) (T, bool) {
+	var zero T
+
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v = reflect.Zero(v.Type().Elem())
+		} else {
+			v = v.Elem()
+		}
 	}
 
-	return fmt.Sprintf("%t", s.underlying)
-}
+	if !v.IsValid() || !v.CanInterface() {
+		return zero, false
+	}
 
-// Bool returns the bool value.
-func (s Bool) Bool() bool {
-	return s.underlying
+	value, ok := v.Interface().(T)
+
+	return value, ok
 }
 
-// BoolPtr returns the bool value as a pointer.
-func (s Bool) BoolPtr() *bool {
-	if s.IsNil() {
+// derefNullable returns the value a pointer to a type in this package points to, see nullableValue,
+// other values are returned as is.
+func derefNullable(v NullableValue) NullableValue {
+	if v == nil {
 		return nil
 	}
-	return &s.underlying
-}
 
-// IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
-func (s Bool) IsDefined() bool {
-	return s.isDefined
+	if nullable, ok := nullableValue(reflect.ValueOf(v)); ok {
+		return nullable
+	}
+
+	return v
 }
 
-// IsNil returns true if the value is nil or undefined.
-func (s Bool) IsNil() bool {
-	// if the value is undefined, it is nil even though "isNil" will be set to false
-	if !s.isDefined {
-		return true
+// FirstDefined returns the first value that is defined and not nil, and true if such a value was found.
+// If no value is found, the zero value (which is undefined for the types in this package) and false is returned.
+func FirstDefined[T NullableValue](vals ...T) (T, bool) {
+	for _, val := range vals {
+		if val.IsDefined() && !val.IsNil() {
+			return val, true
+		}
 	}
 
-	return s.isNil
+	var zero T
+	return zero, false
 }
 
-// IsZero checks if Bool is nil, which is specifically used by sqlboiler queries
-func (s Bool) IsZero() bool { return s.IsNil() }
+// Coalesce returns the first value that is defined and not nil, like COALESCE in SQL,
+// e.g. Coalesce(override, stored, NewString("default")).
+//
+// If no value qualifies, a nil value is returned for the types in this package.
+func Coalesce[T NullableValue](vals ...T) T {
+	val, ok := FirstDefined(vals...)
+	if ok {
+		return val
+	}
 
-// Ptr returns the pointer for Bool, but returns nil if undefined.
-func (s Bool) Ptr() *Bool {
-	if !s.isDefined {
-		return nil
+	// All types in this package become nil when scanning nil
+	if scanner, ok := any(&val).(interface{ Scan(value interface{}) error }); ok {
+		_ = scanner.Scan(nil)
 	}
 
-	return &s
+	return val
 }
 
-// Val returns the value of a Bool-pointer,
-// will return an undefined Bool if the pointer is nil.
-func (s *Bool) Val() Bool {
-	if s == nil {
-		return NewBoolFromPtr(nil)
-	}
+// Ordered is implemented by the types in this package that have a total order,
+// it can be used to compare and sort values of a type that is not known at compile time, see SortAny.
+type Ordered interface {
+	NullableValue
 
-	return *s
+	// Compare returns -1 if the value sorts before other, 1 if it sorts after other and 0 if they are equal.
+	// An error is returned if other is not of the same type.
+	Compare(other any) (int, error)
 }
 
-// MarshalJSON implements the json Marshaler interface.
-//
-// See: https://pkg.go.dev/encoding/json#Marshaler
-func (s Bool) MarshalJSON() ([]byte, error) {
-	if s.IsNil() {
-		return nullBytes, nil
+// compareAny compares s with other, which must be of the same type, nil values sort before all non-nil values.
+func compareAny[T NullableValue](s T, other any, compare func(a, b T) int) (int, error) {
+	o, ok := other.(T)
+	if !ok {
+		return 0, errors.New(fmt.Sprintf("cannot compare %T with %T", s, other))
 	}
 
-	jsonBytes, err := json.Marshal(s.underlying)
-	if err != nil {
-		return nil, errors.Wrap(err, s.String())
+	switch {
+	case s.IsNil() && o.IsNil():
+		return 0, nil
+	case s.IsNil():
+		return -1, nil
+	case o.IsNil():
+		return 1, nil
 	}
 
-	return jsonBytes, nil
+	return compare(s, o), nil
 }
 
-// UnmarshalJSON implements the json Unmarshaler interface.
+// SortAny sorts a slice of values of the same Ordered type in ascending order, with nil values first,
+// e.g. a table column where the type is only known at runtime. The sort is stable.
 //
-// See: https://pkg.go.dev/encoding/json#Unmarshaler
-func (s *Bool) UnmarshalJSON(d []byte) error {
-	s.isNil = isNullBytes(d)
-	s.isDefined = true
+// Pointers are sorted by the values they point to and nil pointers are sorted as undefined values,
+// the pointers themselves are kept in the slice.
+//
+// An error is returned, and the slice is left unchanged, if a value is not Ordered, the types are mixed
+// or the values cannot be compared (such as Money in different currencies).
+func SortAny(values []any) error {
+	type element struct {
+		value   any
+		ordered Ordered
+	}
 
-	if s.isNil {
-		return nil
+	sorted := make([]element, len(values))
+
+	for i, value := range values {
+		ordered, ok := indirectAs[Ordered](reflect.ValueOf(value))
+		if !ok {
+			return errors.New(fmt.Sprintf("cannot sort %T, it does not implement Ordered", value))
+		}
+
+		if reflect.TypeOf(value) != reflect.TypeOf(values[0]) {
+			return errors.New(fmt.Sprintf("cannot sort mixed types %T and %T", values[0], value))
+		}
+
+		sorted[i] = element{value: value, ordered: ordered}
 	}
 
-	err := json.Unmarshal(d, &s.underlying)
+	var err error
+
+	slices.SortStableFunc(sorted, func(a, b element) int {
+		result, compareErr := a.ordered.Compare(b.ordered)
+		if compareErr != nil && err == nil {
+			err = compareErr
+		}
+
+		return result
+	})
+
 	if err != nil {
 		return err
 	}
 
+	for i, element := range sorted {
+		values[i] = element.value
+	}
+
 	return nil
 }
 
-// Scan assigns a value from a database driver and implements the sql Scanner interface.
-//
-// See https://pkg.go.dev/database/sql#Scanner
-func (s *Bool) Scan(value interface{}) error {
-	s.isNil = (nil == value)
-	s.isDefined = true
+// extreme returns the non-nil value which is less than all other values according to less,
+// if there are no non-nil values a nil value is returned. It is used by the Min and Max functions.
+func extreme[T NullableValue](values []T, less func(a, b T) bool) T {
+	result := Coalesce[T]()
 
-	if s.isNil {
-		s.underlying = false
-		return nil
+	for _, value := range values {
+		if value.IsNil() {
+			continue
+		}
+
+		if result.IsNil() || less(value, result) {
+			result = value
+		}
 	}
 
-	return convert.ConvertAssign(&s.underlying, value)
+	return result
 }
 
-// Value implements the driver Valuer interface.
+// Decoder wraps json.Decoder and makes sure that the fields of the types in this package
+// are undefined when they are not present in the JSON object.
 //
-// See https://pkg.go.dev/database/sql/driver#Valuer
-func (s Bool) Value() (driver.Value, error) {
-	if s.IsNil() {
-		return nil, nil
-	}
-	return s.underlying, nil
+// Decoding into a new struct with json.Unmarshal already leaves absent fields undefined,
+// but a struct that is reused keeps the values from the previous decode,
+// which Decoder resets to undefined. Only the top-level fields of the struct are handled.
+type Decoder struct {
+	dec *json.Decoder
 }
 
-// Date is used to represent dates according to the ISO 8601 standard.
-type Date struct {
-	underlying time.Time
-	isDefined  bool
-	isNil      bool
+// NewDecoder returns a new Decoder that reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{dec: json.NewDecoder(r)}
 }
 
-// NewDate creates a new Date object.
-func NewDate(underlying time.Time) Date {
-	return Date{
-		underlying: underlyingTime(underlying, "2006-01-02"),
-		isDefined:  true,
-		isNil:      false,
-	}
-}
+// Decode reads the next JSON value from the input and stores it in v, see json.Decoder.Decode.
+func (d *Decoder) Decode(v any) error {
+	var raw json.RawMessage
 
-// NewDateFromPtr creates a new Date object from a pointer.
-func NewDateFromPtr(underlying *time.Time) Date {
-	if underlying != nil {
-		return NewDate(*underlying)
+	err := d.dec.Decode(&raw)
+	if err != nil {
+		return err
 	}
 
-	return Date{
-		isDefined: true,
-		isNil:     true,
+	err = json.Unmarshal(raw, v)
+	if err != nil {
+		return err
 	}
-}
 
-// NewDateUndefined creates a new undefined Date object.
-func NewDateUndefined() Date {
-	return Date{}
-}
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Pointer || value.Elem().Kind() != reflect.Struct {
+		return nil
+	}
 
-func DateFromStringPtr(strPtr *string) (Date, error) {
-	if strPtr == nil {
-		return NewDateFromPtr(nil), nil
+	var keys map[string]json.RawMessage
+
+	err = json.Unmarshal(raw, &keys)
+	if err != nil {
+		// Not an object, e.g. null, which json.Unmarshal has already handled
+		return nil
 	}
 
-	return DateFromString(*strPtr)
+	resetUndefinedFields(value.Elem(), keys)
+
+	return nil
 }
 
-func DateFromString(str string) (Date, error) {
-	if str == "" {
-		return NewDateFromPtr(nil), nil
+// resetUndefinedFields sets the fields of the types in this package to undefined,
+// if the field has no key in the JSON object. Keys are matched case-insensitively, like encoding/json.
+func resetUndefinedFields(value reflect.Value, keys map[string]json.RawMessage) {
+	present := make(map[string]bool, len(keys))
+	for key := range keys {
+		present[strings.ToLower(key)] = true
 	}
 
-	layouts := []string{
-		"2006-01-02",  // YYYY-MM-DD
-		"01-02-06",    // MM-DD-YY, US format short.. Apparently what excel makes dates into.
-		"02-01-06",    // DD-MM-YY, Reverse order from Excelize
-		"06-01-02",    // YY-MM-DD, Can only happen if Year is > 31 so the above check DD-MM-YY has failed
-		"01-02-2006",  // MM-DD-YYYY, US format
-		"02-Jan-2006", // DD-MMM-YYYY, old style Oracle
-		"02-Jan-06",   // DD-MMM-YY, old style Oracle
-	}
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
 
-	var underlying time.Time
-	var err error
-	for _, layout := range layouts {
-		underlying, err = time.Parse(layout, str)
-		if err == nil {
-			break
+		if _, ok := value.Field(i).Interface().(NullableValue); !ok {
+			continue
+		}
+
+		name := field.Name
+		if tag, _, _ := strings.Cut(field.Tag.Get("json"), ","); tag != "" {
+			if tag == "-" {
+				continue
+			}
+
+			name = tag
+		}
+
+		if !present[strings.ToLower(name)] {
+			value.Field(i).SetZero()
 		}
-		err = errors.New("invalid date format: " + str)
 	}
+}
 
-	if err != nil {
-		return Date{}, err
+// DecodeQuery decodes the query parameters into the fields of the types in this package in the struct v points to,
+// the values are parsed with ParseFromString, so the types registered with RegisterType are supported as well.
+// A StringEnum has to be registered with RegisterType under its reflect type name, since the name depends on
+// the definition, e.g. "types.StringEnum[example.com/app.Status]". An UnixTimestamp is parsed from seconds.
+//
+// The key of a field is taken from the query tag, then the json tag and last the field name, e.g. `query:"from"`.
+// A field without a key in the query is set to undefined and an empty value is parsed as nil,
+// pointer fields are set to a new value or to nil if the key is missing.
+// If a key occurs more than once the first value is used. An error is returned for each field that cannot be parsed.
+func DecodeQuery(values url.Values, v any) []error {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return []error{errors.New(fmt.Sprintf("cannot decode query into %T", v))}
 	}
 
-	return Date{
-		underlying: underlying,
-		isDefined:  true,
-		isNil:      false,
-	}, nil
-}
+	value = value.Elem()
+	nullableValue := reflect.TypeOf((*NullableValue)(nil)).Elem()
 
-// String output Date
-func (s Date) String() string {
-	// If the value is nil we return an empty string
-	if s.IsNil() {
-		return ""
+	var errs []error
+
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		// Pointer fields are decoded into a newly allocated value, and a missing key sets them to nil
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		if !fieldType.Implements(nullableValue) {
+			continue
+		}
+
+		key := queryKey(field)
+		if key == "-" {
+			continue
+		}
+
+		if !values.Has(key) {
+			value.Field(i).SetZero()
+			continue
+		}
+
+		parsed, err := ParseFromString(fieldType.String(), values.Get(key))
+		if err != nil {
+			errs = append(errs, errors.Wrap(err, fmt.Sprintf("cannot decode query parameter %s", key)))
+			continue
+		}
+
+		parsedValue := reflect.ValueOf(parsed)
+		if !parsedValue.Type().AssignableTo(fieldType) {
+			errs = append(errs, errors.New(fmt.Sprintf("cannot decode query parameter %s: %s is not assignable to %s", key, parsedValue.Type(), fieldType)))
+			continue
+		}
+
+		if field.Type.Kind() == reflect.Pointer {
+			pointer := reflect.New(fieldType)
+			pointer.Elem().Set(parsedValue)
+			parsedValue = pointer
+		}
+
+		value.Field(i).Set(parsedValue)
 	}
 
-	return s.underlying.Format("2006-01-02")
+	return errs
 }
 
-// Date returns the time.Time value.
-func (s Date) Date() time.Time {
-	return s.underlying
+// queryKey returns the query parameter name of the field used by DecodeQuery.
+func queryKey(field reflect.StructField) string {
+	for _, tagName := range []string{"query", "json"} {
+		if tag, _, _ := strings.Cut(field.Tag.Get(tagName), ","); tag != "" {
+			return tag
+		}
+	}
+
+	return field.Name
 }
 
-// DatePtr returns the time.Time value as a pointer.
-func (s Date) DatePtr() *time.Time {
-	if s.IsNil() {
-		return nil
+// InitUndefined sets all fields of the types in this package to undefined in the struct v points to,
+// including the fields of nested and embedded structs and non-nil pointers to structs.
+// An error is returned if v is not a non-nil pointer to a struct.
+func InitUndefined(v any) error {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return errors.New(fmt.Sprintf("cannot init undefined fields of %T", v))
 	}
-	return &s.underlying
+
+	initUndefined(value.Elem())
+
+	return nil
 }
 
-// IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
-func (s Date) IsDefined() bool {
-	return s.isDefined
+// ZeroStruct returns a T where all fields of the types in this package are undefined, see InitUndefined.
+func ZeroStruct[T any]() T {
+	var zero T
+	_ = InitUndefined(&zero)
+	return zero
 }
 
-// IsNil returns true if the value is nil or undefined.
-func (s Date) IsNil() bool {
-	// if the value is undefined, it is nil even though "isNil" will be set to false
-	if !s.isDefined {
-		return true
-	}
+func initUndefined(value reflect.Value) {
+	nullableValue := reflect.TypeOf((*NullableValue)(nil)).Elem()
 
-	return s.isNil
-}
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
 
-// IsZero checks if Date is nil, which is specifically used by sqlboiler queries
-func (s Date) IsZero() bool { return s.IsNil() }
+		if field.Type().Implements(nullableValue) {
+			if field.CanSet() {
+				field.SetZero()
+			}
 
-// Ptr returns the pointer for Date, but returns nil if undefined.
-func (s Date) Ptr() *Date {
-	if !s.isDefined {
-		return nil
-	}
+			continue
+		}
 
-	return &s
-}
+		// The exported fields of unexported embedded structs can be set, so they are not skipped
+		switch {
+		case field.Kind() == reflect.Struct:
+			initUndefined(field)
 
-// Val returns the value of a Date-pointer,
-// will return an undefined Date if the pointer is nil.
-func (s *Date) Val() Date {
-	if s == nil {
-		return NewDateFromPtr(nil)
+		case field.Kind() == reflect.Pointer && !field.IsNil() && field.Elem().Kind() == reflect.Struct:
+			initUndefined(field.Elem())
+		}
 	}
-
-	return *s
 }
 
-// MarshalJSON implements the json Marshaler interface.
+// Anonymize returns a copy of the struct v where the fields tagged with `types:"pii"` are scrubbed,
+// e.g. to share a reproduction of a bug without personal data.
 //
-// See: https://pkg.go.dev/encoding/json#Marshaler
-func (s Date) MarshalJSON() ([]byte, error) {
-	if s.IsNil() {
-		return nullBytes, nil
-	}
-
-	jsonBytes, err := json.Marshal(s.underlying.Format("2006-01-02"))
-	if err != nil {
-		return nil, errors.Wrap(err, s.String())
+// String, RichText and Nullable[string] values are replaced with a fake based on a keyed hash of the value
+// and UUID values are hashed, so equal values are still equal after anonymizing. The key is random for each
+// process, so the original values cannot be recovered by hashing guesses. Other types are left intact,
+// as are nil and undefined values. Tagged pointers to the supported types are replaced with a pointer to the fake.
+// Nested and embedded structs are anonymized, but pointers to structs are not followed since they are shared with v.
+func Anonymize[T any](v T) T {
+	value := reflect.ValueOf(&v).Elem()
+	if value.Kind() == reflect.Struct {
+		anonymize(value)
 	}
 
-	return jsonBytes, nil
+	return v
 }
 
-// UnmarshalJSON implements the json Unmarshaler interface.
-//
-// See: https://pkg.go.dev/encoding/json#Unmarshaler
-func (s *Date) UnmarshalJSON(d []byte) error {
-	s.isNil = isNullBytes(d)
-	s.isDefined = true
+func anonymize(value reflect.Value) {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
 
-	if s.isNil {
-		return nil
+		nullable, ok := nullableValue(field)
+		if !ok {
+			if field.Kind() == reflect.Struct {
+				anonymize(field)
+			}
+
+			continue
+		}
+
+		if !field.CanSet() || !slices.Contains(strings.Split(value.Type().Field(i).Tag.Get("types"), ","), "pii") {
+			continue
+		}
+
+		anonymized, ok := anonymizedValue(nullable)
+		if !ok {
+			continue
+		}
+
+		// The pointer is shared with v, so a new pointer is set instead of changing the value it points to
+		if field.Kind() == reflect.Pointer {
+			pointer := reflect.New(field.Type().Elem())
+			pointer.Elem().Set(reflect.ValueOf(anonymized))
+			field.Set(pointer)
+
+			continue
+		}
+
+		field.Set(reflect.ValueOf(anonymized))
 	}
+}
 
-	var str string
-	err := json.Unmarshal(d, &str)
-	if err != nil {
-		return err
+// anonymizedValue returns the fake of the value used by Anonymize, false is returned
+// if the value is nil or undefined or if the type is left intact.
+func anonymizedValue(v NullableValue) (NullableValue, bool) {
+	if v.IsNil() {
+		return nil, false
 	}
 
-	s.underlying, err = time.Parse("2006-01-02", str)
-	if err != nil {
-		return err
+	switch v := v.(type) {
+	case String:
+		return NewString(anonymizedString(v.underlying)), true
+
+	case RichText:
+		return NewRichText("<p>" + anonymizedString(v.underlying) + "</p>"), true
+
+	case Nullable[string]:
+		return NewNullable(anonymizedString(v.underlying)), true
+
+	case UUID:
+		mac := hmac.New(sha256.New, anonymizeKey)
+		_, _ = mac.Write(v.underlying[:])
+
+		return NewUUID(uuid.NewSHA1(uuid.Nil, mac.Sum(nil))), true
 	}
 
-	return nil
+	return nil, false
 }
 
-// Scan assigns a value from a database driver and implements the sql Scanner interface.
-//
-// See https://pkg.go.dev/database/sql#Scanner
-func (s *Date) Scan(value interface{}) error {
-	s.isNil = (nil == value)
-	s.isDefined = true
+// anonymizeKey is the random key of the hashes used by Anonymize.
+var anonymizeKey = func() []byte {
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
 
-	if s.isNil {
-		s.underlying = time.Time{}
-		return nil
-	}
+	return key
+}()
 
-	return convert.ConvertAssign(&s.underlying, value)
+// anonymizedString returns a fake for the string, which is the same for equal strings within the process.
+func anonymizedString(str string) string {
+	mac := hmac.New(sha256.New, anonymizeKey)
+	_, _ = mac.Write([]byte(str))
+
+	return "anonymized-" + hex.EncodeToString(mac.Sum(nil)[:8])
 }
 
-// Value implements the driver Valuer interface.
+// Snapshot returns a stable and human-readable representation of the fields of the types in this package
+// in the struct v, which is suitable for golden files in tests. Each field is written on its own line
+// in the order of the struct, e.g:
 //
-// See https://pkg.go.dev/database/sql/driver#Valuer
-func (s Date) Value() (driver.Value, error) {
-	if s.IsNil() {
-		return nil, nil
+//	Name = "Alice"
+//	Nickname = nil
+//	Address.Street = undefined
+//
+// Defined values are quoted, nested structs and non-nil pointers to structs are prefixed with the field name,
+// the fields of embedded structs are written without a prefix and other fields are left out.
+func Snapshot(v any) string {
+	var b strings.Builder
+
+	value := reflect.Indirect(reflect.ValueOf(v))
+	if value.Kind() == reflect.Struct {
+		snapshot(&b, value, "")
 	}
-	return s.underlying, nil
+
+	return b.String()
 }
 
-// ScanDate implements the [pgtype.DateScanner] interface.
-func (d *Date) ScanDate(v pgtype.Date) error {
-	d.isNil = !v.Valid
-	d.isDefined = true
-	d.underlying = v.Time
+func snapshot(b *strings.Builder, value reflect.Value, prefix string) {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
 
-	return nil
-}
+		// The exported fields of unexported embedded structs are included, like encoding/json
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
 
-// DateValue implements the [pgtype.DateValuer] interface.
-func (d Date) DateValue() (pgtype.Date, error) {
-	return pgtype.Date{
-		Time:             d.underlying,
-		InfinityModifier: pgtype.Finite,
-		Valid:            !d.IsNil(),
-	}, nil
-}
+		fieldValue := value.Field(i)
 
-// Float64 is used to represent 64-bit floating point numbers.
-type Float64 struct {
-	underlying float64
-	isDefined  bool
-	isNil      bool
-}
+		// Pointers to the types in this package are dereferenced and a nil pointer is written as undefined
+		if nullable, ok := nullableValue(fieldValue); ok {
+			b.WriteString(prefix + field.Name + " = " + snapshotValue(nullable) + "\n")
+			continue
+		}
 
-// NewFloat64 creates a new Float64 object.
-func NewFloat64(underlying float64) Float64 {
-	return Float64{
-		underlying: underlying,
-		isDefined:  true,
-		isNil:      false,
+		nestedPrefix := prefix + field.Name + "."
+		if field.Anonymous {
+			nestedPrefix = prefix
+		}
+
+		switch {
+		case fieldValue.Kind() == reflect.Struct:
+			snapshot(b, fieldValue, nestedPrefix)
+
+		case fieldValue.Kind() == reflect.Pointer && !fieldValue.IsNil() && fieldValue.Elem().Kind() == reflect.Struct:
+			snapshot(b, fieldValue.Elem(), nestedPrefix)
+		}
 	}
 }
 
-// NewFloat64FromPtr creates a new Float64 object from a pointer.
-func NewFloat64FromPtr(underlying *float64) Float64 {
-	if underlying != nil {
-		return NewFloat64(*underlying)
+// snapshotValue returns the representation of the value used by Snapshot.
+func snapshotValue(v NullableValue) string {
+	switch {
+	case !v.IsDefined():
+		return "undefined"
+	case v.IsNil():
+		return "nil"
 	}
 
-	return Float64{
-		isDefined: true,
-		isNil:     true,
+	// Float64 and Percent are rounded by String, so they are written with all decimals
+	switch value := v.(type) {
+	case Float64:
+		return strconv.Quote(value.Format(Float64FormatOptions{DecimalSeparator: ".", Precision: -1}))
+	case Percent:
+		return strconv.Quote(NewFloat64(value.underlying).Format(Float64FormatOptions{DecimalSeparator: ".", Precision: -1}) + "%")
+	case fmt.Stringer:
+		return strconv.Quote(value.String())
 	}
-}
 
-// NewFloat64Undefined creates a new undefined Float64 object.
-func NewFloat64Undefined() Float64 {
-	return Float64{}
+	// Types without String, such as Nullable, are written as JSON
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "error: " + err.Error()
+	}
+
+	return string(data)
 }
 
-func Float64FromStringPtr(strPtr *string) (Float64, error) {
-	if strPtr == nil {
-		return NewFloat64FromPtr(nil), nil
+// CacheKey returns a key for the values which can be used in a cache, e.g. CacheKey(schoolID, date, NewInt(page)).
+//
+// The type, the state (nil, undefined or defined) and the exact value are part of the key and each part is quoted,
+// so different values can never produce the same key, nil is distinguished from zero
+// and the key of e.g. NewInt(1) differs from NewString("1").
+func CacheKey(vals ...NullableValue) string {
+	var b strings.Builder
+
+	for i, v := range vals {
+		if i > 0 {
+			b.WriteString(",")
+		}
+
+		// Pointers are dereferenced, so a nil *String has the same key as an undefined String
+		v = derefNullable(v)
+
+		b.WriteString(strconv.Quote(fmt.Sprintf("%T", v)) + "=")
+
+		switch {
+		case v == nil || !v.IsDefined():
+			b.WriteString("undefined")
+		case v.IsNil():
+			b.WriteString("nil")
+		default:
+			b.WriteString(strconv.Quote(cacheKeyValue(v)))
+		}
 	}
 
-	return Float64FromString(*strPtr)
+	return b.String()
 }
 
-func Float64FromString(str string) (Float64, error) {
-	if str == "" {
-		return NewFloat64FromPtr(nil), nil
+// cacheKeyValue returns the exact representation of the value used by CacheKey,
+// the binary representation is used since e.g. String of a Float64 is rounded.
+func cacheKeyValue(v NullableValue) string {
+	if marshaler, ok := v.(encoding.BinaryMarshaler); ok {
+		if data, err := marshaler.MarshalBinary(); err == nil {
+			return string(data)
+		}
 	}
 
-	underlying, err := strconv.ParseFloat(strings.TrimSpace(str), 64)
+	data, err := json.Marshal(v)
 	if err != nil {
-		return Float64{}, err
+		return fmt.Sprintf("%v", v)
 	}
 
-	return Float64{
-		underlying: underlying,
-		isDefined:  true,
-		isNil:      false,
-	}, nil
+	return string(data)
 }
 
-// String output Float64
-func (s Float64) String() string {
-	// If the value is nil we return an empty string
-	if s.IsNil() {
-		return ""
+// hashBinary returns the FNV-1a hash of the binary representation, which includes the state of the value.
+func hashBinary(marshaler encoding.BinaryMarshaler) uint64 {
+	h := fnv.New64a()
+
+	data, err := marshaler.MarshalBinary()
+	if err != nil {
+		// The types in this package only fail to marshal values that could not be constructed
+		data = []byte(err.Error())
 	}
 
-	// First, format the float with two decimal places
-	formatted := fmt.Sprintf("%.2f", s.underlying)
+	_, _ = h.Write(data)
 
-	// Convert to float to trim unnecessary zeros,
-	// ignore the error since we know it shouldn't fail.
-	floatVal, _ := strconv.ParseFloat(formatted, 64)
+	return h.Sum64()
+}
+
+// HashStruct returns a hash of the exported fields of the struct that are types in this package,
+// e.g. to detect if a record has changed since it was last synced. Other fields are ignored.
+//
+// The field names, the states and the values are part of the hash, so two undefined values hash equal,
+// but a nil value hashes differently from an undefined value.
+// An error is returned if v is not a struct or a pointer to a struct.
+func HashStruct(v any) (uint64, error) {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	if value.Kind() != reflect.Struct {
+		return 0, errors.New(fmt.Sprintf("cannot hash %T, expected a struct", v))
+	}
+
+	h := fnv.New64a()
+
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		// Pointers to the types in this package are dereferenced and a nil pointer is hashed as undefined
+		nullable, ok := nullableValue(value.Field(i))
+		if !ok {
+			continue
+		}
+
+		var fieldHash uint64
+
+		switch hasher := nullable.(type) {
+		case interface{ Hash() uint64 }:
+			fieldHash = hasher.Hash()
+		default:
+			// Nullable and custom types are hashed using the same representation as CacheKey
+			fieldHash = fnv64a(CacheKey(nullable))
+		}
 
-	// Reformat the float without unnecessary zeros
-	formatted = fmt.Sprintf("%g", floatVal)
+		_, _ = h.Write([]byte(field.Name))
+		_, _ = h.Write(binary.BigEndian.AppendUint64([]byte{0}, fieldHash))
+	}
 
-	// Replace dot with comma
-	return strings.Replace(formatted, ".", ",", 1)
+	return h.Sum64(), nil
 }
 
-// Float64 returns the float64 value.
-func (s Float64) Float64() float64 {
-	return s.underlying
+// fnv64a returns the FNV-1a hash of the string.
+func fnv64a(str string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(str))
+
+	return h.Sum64()
 }
 
-// Float64Ptr returns the float64 value as a pointer.
-func (s Float64) Float64Ptr() *float64 {
-	if s.IsNil() {
-		return nil
-	}
-	return &s.underlying
+// CSVValuer is implemented by all types in this package and is used by MarshalCSVRecord.
+type CSVValuer interface {
+	CSVValue() string
 }
 
-// IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
-func (s Float64) IsDefined() bool {
-	return s.isDefined
+// CSVOptions are the options used by MarshalCSVRecord.
+type CSVOptions struct {
+	// NullToken is written for nil and undefined values, e.g. "NULL" or `\N` for Postgres COPY.
+	// The default is an empty field.
+	NullToken string
+
+	// Float64Precision is the maximum number of decimals of Float64 values, which are rounded to the precision.
+	// The default is nil, which writes all decimals so that no precision is lost.
+	Float64Precision *int
 }
 
-// IsNil returns true if the value is nil or undefined.
-func (s Float64) IsNil() bool {
-	// if the value is undefined, it is nil even though "isNil" will be set to false
-	if !s.isDefined {
-		return true
+// MarshalCSVRecord returns the exported fields of the struct as a CSV record, which can be written with encoding/csv.
+//
+// The types in this package are written using CSVValue, other fields using fmt.Sprint,
+// and nil or undefined values are written as the NullToken.
+// An error is returned if v is not a struct or a pointer to a struct.
+func MarshalCSVRecord(v any, options CSVOptions) ([]string, error) {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	if value.Kind() != reflect.Struct {
+		return nil, errors.New(fmt.Sprintf("cannot marshal csv record from %T", v))
 	}
 
-	return s.isNil
+	record := make([]string, 0, value.NumField())
+
+	for i := 0; i < value.NumField(); i++ {
+		if !value.Type().Field(i).IsExported() {
+			continue
+		}
+
+		field := value.Field(i).Interface()
+
+		// Pointers to the types in this package are dereferenced and a nil pointer is written as undefined
+		nullable, ok := nullableValue(value.Field(i))
+		if ok {
+			field = nullable
+		}
+
+		if ok && nullable.IsNil() {
+			record = append(record, options.NullToken)
+			continue
+		}
+
+		if float, isFloat := field.(Float64); isFloat && options.Float64Precision != nil {
+			record = append(record, float.Format(Float64FormatOptions{DecimalSeparator: ".", Precision: *options.Float64Precision}))
+			continue
+		}
+
+		if valuer, ok := field.(CSVValuer); ok {
+			record = append(record, valuer.CSVValue())
+			continue
+		}
+
+		record = append(record, fmt.Sprint(field))
+	}
+
+	return record, nil
 }
 
-// IsZero checks if Float64 is nil, which is specifically used by sqlboiler queries
-func (s Float64) IsZero() bool { return s.IsNil() }
+// Validator is implemented by all types in this package.
+type Validator interface {
+	Validate(rules ...Rule) error
+}
+
+// Rule is a validation rule which is used by Validate, e.g. NewString("").Validate(Required(), WithMaxLength(100)).
+//
+// Nil and undefined values are valid and skip the rules, unless the Required rule is used.
+// Custom rules can be created with NewRule.
+type Rule struct {
+	required bool
+	check    func(value any) error
+}
+
+// NewRule creates a rule from the check, which receives the value being validated (e.g. a String),
+// the check is never called for nil or undefined values.
+func NewRule(check func(value any) error) Rule {
+	return Rule{check: check}
+}
+
+// Required is a rule which makes nil and undefined values invalid.
+func Required() Rule {
+	return Rule{required: true}
+}
+
+// WithMaxLength is a rule for String and RichText, which limits the number of characters in the value.
+// For RichText the visible text is counted rather than the HTML, like ValidateMaxChars.
+func WithMaxLength(n int) Rule {
+	return NewRule(func(value any) error {
+		var length int
+
+		switch v := value.(type) {
+		case String:
+			length = utf8.RuneCountInString(v.underlying)
+		case RichText:
+			text, err := v.Text()
+			if err != nil {
+				return err
+			}
+
+			length = utf8.RuneCountInString(strings.TrimSpace(text))
+		default:
+			return errors.New(fmt.Sprintf("max length rule is not supported for %T", value))
+		}
+
+		if length > n {
+			return errors.New(fmt.Sprintf("length %d exceeds the max length %d", length, n))
+		}
 
-// Ptr returns the pointer for Float64, but returns nil if undefined.
-func (s Float64) Ptr() *Float64 {
-	if !s.isDefined {
 		return nil
-	}
+	})
+}
+
+// WithRange is a rule for Float64, Int, Int16, Int64 and Percent (the whole percentage),
+// which requires the value to be within min and max (inclusive).
+func WithRange(min, max float64) Rule {
+	return NewRule(func(value any) error {
+		var number float64
+
+		switch v := value.(type) {
+		case Float64:
+			number = v.underlying
+		case Int:
+			number = float64(v.underlying)
+		case Int16:
+			number = float64(v.underlying)
+		case Int64:
+			number = float64(v.underlying)
+		case Percent:
+			number = v.underlying
+		default:
+			return errors.New(fmt.Sprintf("range rule is not supported for %T", value))
+		}
 
-	return &s
+		if number < min || number > max {
+			return errors.New(fmt.Sprintf("value %v is not within the range %v to %v", number, min, max))
+		}
+
+		return nil
+	})
+}
+
+// WithNotInFuture is a rule for Date and Timestamp, see Date.NotInFuture and Timestamp.NotAfterNow.
+func WithNotInFuture(location *time.Location) Rule {
+	return NewRule(func(value any) error {
+		switch v := value.(type) {
+		case Date:
+			return v.NotInFuture(location)
+		case Timestamp:
+			return v.NotAfterNow(location)
+		default:
+			return errors.New(fmt.Sprintf("not in future rule is not supported for %T", value))
+		}
+	})
 }
 
-// Val returns the value of a Float64-pointer,
-// will return an undefined Float64 if the pointer is nil.
-func (s *Float64) Val() Float64 {
-	if s == nil {
-		return NewFloat64FromPtr(nil)
+func validate(value NullableValue, rules []Rule) error {
+	if value.IsNil() {
+		for _, rule := range rules {
+			if rule.required {
+				return errors.New("value is required")
+			}
+		}
+
+		return nil
 	}
 
-	return *s
+	for _, rule := range rules {
+		if rule.check == nil {
+			continue
+		}
+
+		err := rule.check(value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-// MarshalJSON implements the json Marshaler interface.
+// ValidateStruct validates the exported fields of the struct v that are types in this package
+// and returns the error messages keyed by the JSON field name, e.g. for a 422 response.
+// An empty map is returned if all fields are valid.
 //
-// See: https://pkg.go.dev/encoding/json#Marshaler
-func (s Float64) MarshalJSON() ([]byte, error) {
-	if s.IsNil() {
-		return nullBytes, nil
+// The rules are read from the types tag, which may contain required, maxlength=N and range=MIN:MAX, e.g.
+//
+//	Name  types.String `json:"name" types:"required,maxlength=100"`
+//	Grade types.Int    `json:"grade" types:"range=1:5"`
+//
+// Fields of embedded structs are validated as if they were fields of v and nested structs are prefixed,
+// e.g. "address.street". A nil pointer to a type in this package is validated as undefined.
+// An error is returned if v is not a struct or a pointer to a struct, or if a tag is invalid or contains
+// an unknown option, since both are programming errors rather than invalid input.
+func ValidateStruct(v any) (map[string]string, error) {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	if value.Kind() != reflect.Struct {
+		return nil, errors.New(fmt.Sprintf("types: ValidateStruct expects a struct, got %T", v))
 	}
 
-	jsonBytes, err := json.Marshal(s.underlying)
+	messages := map[string]string{}
+
+	err := validateStruct(messages, value, "")
 	if err != nil {
-		return nil, errors.Wrap(err, s.String())
+		return nil, err
 	}
 
-	return jsonBytes, nil
+	return messages, nil
 }
 
-// UnmarshalJSON implements the json Unmarshaler interface.
-//
-// See: https://pkg.go.dev/encoding/json#Unmarshaler
-func (s *Float64) UnmarshalJSON(d []byte) error {
-	s.isNil = isNullBytes(d)
-	s.isDefined = true
+func validateStruct(messages map[string]string, value reflect.Value, prefix string) error {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
 
-	if s.isNil {
-		return nil
-	}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
 
-	err := json.Unmarshal(d, &s.underlying)
-	if err != nil {
-		return err
+		if name == "" {
+			name = field.Name
+		}
+
+		fieldValue := value.Field(i)
+
+		// A nil pointer to a type in this package is validated as undefined, so only required can fail
+		if validator, ok := indirectAs[Validator](fieldValue); ok {
+			rules, err := tagRules(field)
+			if err != nil {
+				return err
+			}
+
+			err = validator.Validate(rules...)
+			if err != nil {
+				messages[prefix+name] = err.Error()
+			}
+
+			continue
+		}
+
+		nestedPrefix := prefix + name + "."
+		if field.Anonymous {
+			nestedPrefix = prefix
+		}
+
+		var err error
+
+		switch {
+		case fieldValue.Kind() == reflect.Struct:
+			err = validateStruct(messages, fieldValue, nestedPrefix)
+
+		case fieldValue.Kind() == reflect.Pointer && !fieldValue.IsNil() && fieldValue.Elem().Kind() == reflect.Struct:
+			err = validateStruct(messages, fieldValue.Elem(), nestedPrefix)
+		}
+
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-// Scan assigns a value from a database driver and implements the sql Scanner interface.
-//
-// See https://pkg.go.dev/database/sql#Scanner
-func (s *Float64) Scan(value interface{}) error {
-	s.isNil = (nil == value)
-	s.isDefined = true
+// tagRules returns the rules of the types tag of the field, see ValidateStruct. The pii option is used by Anonymize
+// and is skipped, an error is returned for unknown options so that a misspelled rule is not silently ignored.
+func tagRules(field reflect.StructField) ([]Rule, error) {
+	rules := []Rule{}
 
-	if s.isNil {
-		s.underlying = 0
-		return nil
+	tag := field.Tag.Get("types")
+	if tag == "" {
+		return rules, nil
 	}
 
-	return convert.ConvertAssign(&s.underlying, value)
+	for _, option := range strings.Split(tag, ",") {
+		key, arg, _ := strings.Cut(option, "=")
+
+		switch key {
+		case "pii":
+			continue
+
+		case "required":
+			rules = append(rules, Required())
+
+		case "maxlength":
+			n, err := strconv.Atoi(arg)
+			if err != nil {
+				return nil, errors.New(fmt.Sprintf("types: invalid maxlength %q for field %s", arg, field.Name))
+			}
+
+			rules = append(rules, WithMaxLength(n))
+
+		case "range":
+			minArg, maxArg, _ := strings.Cut(arg, ":")
+
+			min, minErr := strconv.ParseFloat(minArg, 64)
+			max, maxErr := strconv.ParseFloat(maxArg, 64)
+			if minErr != nil || maxErr != nil {
+				return nil, errors.New(fmt.Sprintf("types: invalid range %q for field %s, expected MIN:MAX", arg, field.Name))
+			}
+
+			rules = append(rules, WithRange(min, max))
+
+		default:
+			return nil, errors.New(fmt.Sprintf("types: unknown option %q for field %s", option, field.Name))
+		}
+	}
+
+	return rules, nil
 }
 
-// Value implements the driver Valuer interface.
+// HumanDiff describes the changes between two structs of the same type as readable sentences,
+// e.g. `Name changed from "Alice" to "Bob"`, which can be used in change notifications.
 //
-// See https://pkg.go.dev/database/sql/driver#Valuer
-func (s Float64) Value() (driver.Value, error) {
-	if s.IsNil() {
-		return nil, nil
+// The labels are used instead of the field names when present. Fields that are undefined in new
+// are skipped since they were not touched, as well as unexported fields. Nested structs are compared
+// field by field and their fields are labeled by the path, e.g. "Address.Street".
+// Nil is returned if old and new are not structs (or pointers to structs) of the same type.
+func HumanDiff(old, new any, labels map[string]string) []string {
+	oldValue := reflect.Indirect(reflect.ValueOf(old))
+	newValue := reflect.Indirect(reflect.ValueOf(new))
+
+	if oldValue.Kind() != reflect.Struct || oldValue.Type() != newValue.Type() {
+		return nil
 	}
-	return s.underlying, nil
+
+	return humanDiff(oldValue, newValue, labels, "")
 }
 
-// Int is used to represent integers.
-type Int struct {
-	underlying int
+func humanDiff(oldValue, newValue reflect.Value, labels map[string]string, prefix string) []string {
+	var changes []string
+
+	for i := 0; i < newValue.NumField(); i++ {
+		field := newValue.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		path := prefix + field.Name
+
+		label, ok := labels[path]
+		if !ok {
+			label = path
+		}
+
+		// Pointers to the types in this package are dereferenced and a nil pointer is undefined
+		oldNullable, ok := nullableValue(oldValue.Field(i))
+		if !ok {
+			oldField := reflect.Indirect(oldValue.Field(i))
+			newField := reflect.Indirect(newValue.Field(i))
+
+			if isNestedStruct(oldField) && isNestedStruct(newField) {
+				changes = append(changes, humanDiff(oldField, newField, labels, path+".")...)
+				continue
+			}
+
+			if !reflect.DeepEqual(oldValue.Field(i).Interface(), newValue.Field(i).Interface()) {
+				changes = append(changes, fmt.Sprintf("%s changed from %q to %q", label, humanString(oldField), humanString(newField)))
+			}
+
+			continue
+		}
+
+		newNullable, _ := nullableValue(newValue.Field(i))
+
+		switch {
+		case !newNullable.IsDefined():
+			continue
+
+		case oldNullable.IsNil() && newNullable.IsNil():
+			continue
+
+		case oldNullable.IsNil():
+			changes = append(changes, fmt.Sprintf("%s set to %q", label, humanString(reflect.ValueOf(newNullable))))
+
+		case newNullable.IsNil():
+			changes = append(changes, fmt.Sprintf("%s cleared, was %q", label, humanString(reflect.ValueOf(oldNullable))))
+
+		case !humanEqual(reflect.ValueOf(oldNullable), reflect.ValueOf(newNullable)):
+			changes = append(changes, fmt.Sprintf(
+				"%s changed from %q to %q",
+				label,
+				humanString(reflect.ValueOf(oldNullable)),
+				humanString(reflect.ValueOf(newNullable)),
+			))
+		}
+	}
+
+	return changes
+}
+
+// isNestedStruct returns true if v is a struct that HumanDiff compares field by field,
+// structs that implement fmt.Stringer such as time.Time are compared as a whole.
+func isNestedStruct(v reflect.Value) bool {
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+
+	_, ok := v.Interface().(fmt.Stringer)
+
+	return !ok
+}
+
+// humanEqual compares the values with their Equal method if they have one, e.g. Timestamp.Equal,
+// otherwise the values are compared with reflect.DeepEqual so that the output of String isn't compared,
+// e.g. a Float64 that is rounded by String.
+func humanEqual(a, b reflect.Value) bool {
+	equal := a.MethodByName("Equal")
+	if equal.IsValid() && equal.Type().NumIn() == 1 && equal.Type().In(0) == b.Type() &&
+		equal.Type().NumOut() == 1 && equal.Type().Out(0).Kind() == reflect.Bool {
+		return equal.Call([]reflect.Value{b})[0].Bool()
+	}
+
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
+// humanString returns the value as shown by HumanDiff, which is String if the value implements fmt.Stringer,
+// the underlying value of a Nullable or the value formatted by fmt.Sprint.
+func humanString(v reflect.Value) string {
+	if !v.IsValid() {
+		return ""
+	}
+
+	if stringer, ok := v.Interface().(fmt.Stringer); ok {
+		return stringer.String()
+	}
+
+	underlying := v.MethodByName("Underlying")
+	if underlying.IsValid() && underlying.Type().NumIn() == 0 && underlying.Type().NumOut() == 1 {
+		return fmt.Sprint(underlying.Call(nil)[0].Interface())
+	}
+
+	return fmt.Sprint(v.Interface())
+}
+
+// Bool is used to represent booleans
+type Bool struct {
+	underlying bool
 	isDefined  bool
 	isNil      bool
 }
 
-// NewInt creates a new Int object.
-func NewInt(underlying int) Int {
-	return Int{
+// NewBool creates a new Bool object.
+func NewBool(underlying bool) Bool {
+	return Bool{
 		underlying: underlying,
 		isDefined:  true,
 		isNil:      false,
 	}
 }
 
-// NewIntFromPtr creates a new Int object from a pointer.
-func NewIntFromPtr(underlying *int) Int {
+// NewBoolFromPtr creates a new Bool object from a pointer.
+func NewBoolFromPtr(underlying *bool) Bool {
 	if underlying != nil {
-		return NewInt(*underlying)
+		return NewBool(*underlying)
 	}
 
-	return Int{
+	return Bool{
 		isDefined: true,
 		isNil:     true,
 	}
 }
 
-// NewIntUndefined creates a new undefined Int object.
-func NewIntUndefined() Int {
-	return Int{}
+// NewBoolUndefined creates a new undefined Bool object.
+func NewBoolUndefined() Bool {
+	return Bool{}
 }
 
-func IntFromStringPtr(strPtr *string) (Int, error) {
+func BoolFromStringPtr(strPtr *string) (Bool, error) {
 	if strPtr == nil {
-		return NewIntFromPtr(nil), nil
+		return NewBoolFromPtr(nil), nil
 	}
 
-	return IntFromString(*strPtr)
+	return BoolFromString(*strPtr)
 }
 
-func IntFromString(str string) (Int, error) {
+func BoolFromString(str string) (Bool, error) {
 	if str == "" {
-		return NewIntFromPtr(nil), nil
+		return NewBoolFromPtr(nil), nil
 	}
 
-	parsed, err := strconv.ParseInt(strings.TrimSpace(str), 10, 64)
-	underlying := int(parsed)
-
+	underlying, err := strconv.ParseBool(strings.TrimSpace(str))
 	if err != nil {
-		return Int{}, err
+		return Bool{}, err
 	}
 
-	return Int{
+	return Bool{
 		underlying: underlying,
 		isDefined:  true,
 		isNil:      false,
 	}, nil
 }
 
-// String output Int
-func (s Int) String() string {
+// MustBoolFromString is like BoolFromString but panics if the string cannot be parsed, like uuid.MustParse.
+// It is meant for tests and package-level variables, e.g. MustBoolFromString("true"), never for untrusted input.
+func MustBoolFromString(str string) Bool {
+	return must(BoolFromString(str))
+}
+
+// BoolsFromStrings parses the strings with BoolFromString,
+// an error is returned for the first string that cannot be parsed.
+func BoolsFromStrings(strs []string) ([]Bool, error) {
+	return fromStrings(strs, BoolFromString)
+}
+
+// BoolsToStrings converts the values to strings with String, a nil value becomes an empty string.
+func BoolsToStrings(values []Bool) []string {
+	return toStrings(values)
+}
+
+// String output Bool
+func (s Bool) String() string {
 	// If the value is nil we return an empty string
 	if s.IsNil() {
 		return ""
 	}
 
-	return fmt.Sprintf("%d", s.underlying)
+	return fmt.Sprintf("%t", s.underlying)
 }
 
-// Int returns the int value.
-func (s Int) Int() int {
+// CSVValue returns the value for a CSV field, which is the same as String and an empty string for nil.
+func (s Bool) CSVValue() string {
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.String()
+}
+
+// Bool returns the bool value.
+func (s Bool) Bool() bool {
 	return s.underlying
 }
 
-// IntPtr returns the int value as a pointer.
-func (s Int) IntPtr() *int {
+// BoolPtr returns the bool value as a pointer.
+func (s Bool) BoolPtr() *bool {
 	if s.IsNil() {
 		return nil
 	}
@@ -788,12 +1661,12 @@ func (s Int) IntPtr() *int {
 }
 
 // IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
-func (s Int) IsDefined() bool {
+func (s Bool) IsDefined() bool {
 	return s.isDefined
 }
 
 // IsNil returns true if the value is nil or undefined.
-func (s Int) IsNil() bool {
+func (s Bool) IsNil() bool {
 	// if the value is undefined, it is nil even though "isNil" will be set to false
 	if !s.isDefined {
 		return true
@@ -802,11 +1675,11 @@ func (s Int) IsNil() bool {
 	return s.isNil
 }
 
-// IsZero checks if Int is nil, which is specifically used by sqlboiler queries
-func (s Int) IsZero() bool { return s.IsNil() }
+// IsZero checks if Bool is nil, which is specifically used by sqlboiler queries
+func (s Bool) IsZero() bool { return s.IsNil() }
 
-// Ptr returns the pointer for Int, but returns nil if undefined.
-func (s Int) Ptr() *Int {
+// Ptr returns the pointer for Bool, but returns nil if undefined.
+func (s Bool) Ptr() *Bool {
 	if !s.isDefined {
 		return nil
 	}
@@ -814,20 +1687,59 @@ func (s Int) Ptr() *Int {
 	return &s
 }
 
-// Val returns the value of a Int-pointer,
-// will return an undefined Int if the pointer is nil.
-func (s *Int) Val() Int {
+// Val returns the value of a Bool-pointer,
+// will return an undefined Bool if the pointer is nil.
+func (s *Bool) Val() Bool {
 	if s == nil {
-		return NewIntFromPtr(nil)
+		return NewBoolFromPtr(nil)
 	}
 
 	return *s
 }
 
+// Set sets the Bool to the value in place, which is the same as assigning NewBool(v).
+func (s *Bool) Set(v bool) {
+	*s = NewBool(v)
+}
+
+// SetNil sets the Bool to nil in place, which is the same as assigning NewBoolFromPtr(nil), so it is still defined.
+func (s *Bool) SetNil() {
+	*s = NewBoolFromPtr(nil)
+}
+
+// SetUndefined sets the Bool to undefined in place, which is the same as assigning NewBoolUndefined().
+func (s *Bool) SetUndefined() {
+	*s = NewBoolUndefined()
+}
+
+// Validate validates the Bool against the rules, see Rule.
+func (s Bool) Validate(rules ...Rule) error {
+	return validate(s, rules)
+}
+
+// Compare implements the Ordered interface, nil values sort before all non-nil values and false sorts before true.
+func (s Bool) Compare(other any) (int, error) {
+	return compareAny(s, other, func(a, b Bool) int {
+		switch {
+		case a.underlying == b.underlying:
+			return 0
+		case !a.underlying:
+			return -1
+		default:
+			return 1
+		}
+	})
+}
+
+// Hash returns a hash of the state and the value, which can be used for change detection, see HashStruct.
+func (s Bool) Hash() uint64 {
+	return hashBinary(s)
+}
+
 // MarshalJSON implements the json Marshaler interface.
 //
 // See: https://pkg.go.dev/encoding/json#Marshaler
-func (s Int) MarshalJSON() ([]byte, error) {
+func (s Bool) MarshalJSON() ([]byte, error) {
 	if s.IsNil() {
 		return nullBytes, nil
 	}
@@ -843,7 +1755,7 @@ func (s Int) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements the json Unmarshaler interface.
 //
 // See: https://pkg.go.dev/encoding/json#Unmarshaler
-func (s *Int) UnmarshalJSON(d []byte) error {
+func (s *Bool) UnmarshalJSON(d []byte) error {
 	s.isNil = isNullBytes(d)
 	s.isDefined = true
 
@@ -859,15 +1771,127 @@ func (s *Int) UnmarshalJSON(d []byte) error {
 	return nil
 }
 
+// MarshalYAML implements the yaml Marshaler interface.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Marshaler
+func (s Bool) MarshalYAML() (interface{}, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+
+	return s.underlying, nil
+}
+
+// UnmarshalYAML implements the yaml Unmarshaler interface.
+//
+// Note that yaml.v3 does not call UnmarshalYAML for null values,
+// so a null in the YAML input leaves the value undefined.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Unmarshaler
+func (s *Bool) UnmarshalYAML(value *yaml.Node) error {
+	s.isNil = false
+	s.isDefined = true
+
+	return value.Decode(&s.underlying)
+}
+
+// MarshalBinary implements the encoding BinaryMarshaler interface,
+// the value is encoded as a state byte (undefined, nil or defined) followed by the value.
+//
+// See: https://pkg.go.dev/encoding#BinaryMarshaler
+func (s Bool) MarshalBinary() ([]byte, error) {
+	if s.IsNil() {
+		return marshalBinaryNil(s.isDefined), nil
+	}
+
+	if s.underlying {
+		return []byte{binaryDefined, 1}, nil
+	}
+
+	return []byte{binaryDefined, 0}, nil
+}
+
+// UnmarshalBinary implements the encoding BinaryUnmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (s *Bool) UnmarshalBinary(d []byte) error {
+	isDefined, isNil, payload, err := unmarshalBinaryState(d)
+	if err != nil {
+		return err
+	}
+
+	var underlying bool
+	if isDefined && !isNil {
+		err := checkBinaryLength(payload, 1)
+		if err != nil {
+			return err
+		}
+
+		underlying = payload[0] == 1
+	}
+
+	*s = Bool{
+		underlying: underlying,
+		isDefined:  isDefined,
+		isNil:      isNil,
+	}
+
+	return nil
+}
+
+// EncodeMsgpack implements the msgpack CustomEncoder interface,
+// nil and undefined values are encoded as an extension type to keep the state.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomEncoder
+func (s Bool) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if s.IsNil() {
+		return encodeMsgpackNil(enc, s.isDefined)
+	}
+
+	return enc.EncodeBool(s.underlying)
+}
+
+// DecodeMsgpack implements the msgpack CustomDecoder interface.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomDecoder
+func (s *Bool) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isDefined, isNil, err := decodeMsgpackState(dec)
+	if err != nil {
+		return err
+	}
+
+	if !isDefined || isNil {
+		*s = Bool{
+			isDefined: isDefined,
+			isNil:     isNil,
+		}
+
+		return nil
+	}
+
+	underlying, err := dec.DecodeBool()
+	if err != nil {
+		return err
+	}
+
+	*s = Bool{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}
+
+	return nil
+}
+
 // Scan assigns a value from a database driver and implements the sql Scanner interface.
 //
 // See https://pkg.go.dev/database/sql#Scanner
-func (s *Int) Scan(value interface{}) error {
+func (s *Bool) Scan(value interface{}) error {
 	s.isNil = (nil == value)
 	s.isDefined = true
 
 	if s.isNil {
-		s.underlying = 0
+		s.underlying = false
 		return nil
 	}
 
@@ -877,90 +1901,148 @@ func (s *Int) Scan(value interface{}) error {
 // Value implements the driver Valuer interface.
 //
 // See https://pkg.go.dev/database/sql/driver#Valuer
-func (s Int) Value() (driver.Value, error) {
+func (s Bool) Value() (driver.Value, error) {
 	if s.IsNil() {
 		return nil, nil
 	}
-	return int64(s.underlying), nil
+	return s.underlying, nil
 }
 
-// Int16 is used to represent 16-bit integers.
-type Int16 struct {
-	underlying int16
+// BoolText is a Bool which is stored as the text "true" or "false" in the database,
+// e.g. for legacy tables storing booleans in a VARCHAR column.
+//
+// Everything except Scan and Value behaves as Bool.
+type BoolText struct {
+	Bool
+}
+
+// NewBoolTextMode wraps the Bool so it is stored as text in the database.
+func NewBoolTextMode(b Bool) BoolText {
+	return BoolText{Bool: b}
+}
+
+// Scan implements the sql Scanner interface, it reads the text "true" or "false".
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (s *BoolText) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		value = strings.TrimSpace(string(v))
+	case string:
+		value = strings.TrimSpace(v)
+	}
+
+	return s.Bool.Scan(value)
+}
+
+// Value implements the driver Valuer interface, it returns the text "true" or "false".
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (s BoolText) Value() (driver.Value, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+	return strconv.FormatBool(s.underlying), nil
+}
+
+// Bytes is used to represent binary data, e.g. BYTEA columns,
+// it is represented as a standard base64 encoded string in JSON.
+//
+// An empty Bytes is not nil, so it is stored as an empty BYTEA and not as NULL.
+type Bytes struct {
+	underlying []byte
 	isDefined  bool
 	isNil      bool
 }
 
-// NewInt16 creates a new Int16 object.
-func NewInt16(underlying int16) Int16 {
-	return Int16{
+// NewBytes creates a new Bytes object.
+func NewBytes(underlying []byte) Bytes {
+	if underlying == nil {
+		underlying = []byte{}
+	}
+
+	return Bytes{
 		underlying: underlying,
 		isDefined:  true,
 		isNil:      false,
 	}
 }
 
-// NewInt16FromPtr creates a new Int16 object from a pointer.
-func NewInt16FromPtr(underlying *int16) Int16 {
+// NewBytesFromPtr creates a new Bytes object from a pointer.
+func NewBytesFromPtr(underlying *[]byte) Bytes {
 	if underlying != nil {
-		return NewInt16(*underlying)
+		return NewBytes(*underlying)
 	}
 
-	return Int16{
+	return Bytes{
 		isDefined: true,
 		isNil:     true,
 	}
 }
 
-// NewInt16Undefined creates a new undefined Int16 object.
-func NewInt16Undefined() Int16 {
-	return Int16{}
+// NewBytesUndefined creates a new undefined Bytes object.
+func NewBytesUndefined() Bytes {
+	return Bytes{}
 }
 
-func Int16FromStringPtr(strPtr *string) (Int16, error) {
+func BytesFromStringPtr(strPtr *string) (Bytes, error) {
 	if strPtr == nil {
-		return NewInt16FromPtr(nil), nil
+		return NewBytesFromPtr(nil), nil
 	}
 
-	return Int16FromString(*strPtr)
+	return BytesFromString(*strPtr)
 }
 
-func Int16FromString(str string) (Int16, error) {
+// BytesFromString decodes the standard base64 encoded string.
+func BytesFromString(str string) (Bytes, error) {
 	if str == "" {
-		return NewInt16FromPtr(nil), nil
+		return NewBytesFromPtr(nil), nil
 	}
 
-	parsed, err := strconv.ParseInt(strings.TrimSpace(str), 10, 64)
-	underlying := int16(parsed)
-
+	underlying, err := base64.StdEncoding.DecodeString(strings.TrimSpace(str))
 	if err != nil {
-		return Int16{}, err
+		return Bytes{}, err
 	}
 
-	return Int16{
+	return Bytes{
 		underlying: underlying,
 		isDefined:  true,
 		isNil:      false,
 	}, nil
 }
 
-// String output Int16
-func (s Int16) String() string {
+// MustBytesFromString is like BytesFromString but panics if the string cannot be parsed, like uuid.MustParse.
+// It is meant for tests and package-level variables, e.g. MustBytesFromString("aGVsbG8="), never for untrusted input.
+func MustBytesFromString(str string) Bytes {
+	return must(BytesFromString(str))
+}
+
+// String returns the value as a standard base64 encoded string.
+func (s Bytes) String() string {
 	// If the value is nil we return an empty string
 	if s.IsNil() {
 		return ""
 	}
 
-	return fmt.Sprintf("%d", s.underlying)
+	return base64.StdEncoding.EncodeToString(s.underlying)
 }
 
-// Int16 returns the int16 value.
-func (s Int16) Int16() int16 {
+// CSVValue returns the value for a CSV field, which is the same as String and an empty string for nil.
+func (s Bytes) CSVValue() string {
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.String()
+}
+
+// Bytes returns the []byte value.
+func (s Bytes) Bytes() []byte {
 	return s.underlying
 }
 
-// Int16Ptr returns the int16 value as a pointer.
-func (s Int16) Int16Ptr() *int16 {
+// BytesPtr returns the []byte value as a pointer.
+func (s Bytes) BytesPtr() *[]byte {
 	if s.IsNil() {
 		return nil
 	}
@@ -968,12 +2050,12 @@ func (s Int16) Int16Ptr() *int16 {
 }
 
 // IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
-func (s Int16) IsDefined() bool {
+func (s Bytes) IsDefined() bool {
 	return s.isDefined
 }
 
 // IsNil returns true if the value is nil or undefined.
-func (s Int16) IsNil() bool {
+func (s Bytes) IsNil() bool {
 	// if the value is undefined, it is nil even though "isNil" will be set to false
 	if !s.isDefined {
 		return true
@@ -982,11 +2064,11 @@ func (s Int16) IsNil() bool {
 	return s.isNil
 }
 
-// IsZero checks if Int16 is nil, which is specifically used by sqlboiler queries
-func (s Int16) IsZero() bool { return s.IsNil() }
+// IsZero checks if Bytes is nil, which is specifically used by sqlboiler queries
+func (s Bytes) IsZero() bool { return s.IsNil() }
 
-// Ptr returns the pointer for Int16, but returns nil if undefined.
-func (s Int16) Ptr() *Int16 {
+// Ptr returns the pointer for Bytes, but returns nil if undefined.
+func (s Bytes) Ptr() *Bytes {
 	if !s.isDefined {
 		return nil
 	}
@@ -994,25 +2076,50 @@ func (s Int16) Ptr() *Int16 {
 	return &s
 }
 
-// Val returns the value of a Int16-pointer,
-// will return an undefined Int16 if the pointer is nil.
-func (s *Int16) Val() Int16 {
+// Val returns the value of a Bytes-pointer,
+// will return an undefined Bytes if the pointer is nil.
+func (s *Bytes) Val() Bytes {
 	if s == nil {
-		return NewInt16FromPtr(nil)
+		return NewBytesFromPtr(nil)
 	}
 
 	return *s
 }
 
+// Set sets the Bytes to the value in place, which is the same as assigning NewBytes(v).
+func (s *Bytes) Set(v []byte) {
+	*s = NewBytes(v)
+}
+
+// SetNil sets the Bytes to nil in place, which is the same as assigning NewBytesFromPtr(nil), so it is still defined.
+func (s *Bytes) SetNil() {
+	*s = NewBytesFromPtr(nil)
+}
+
+// SetUndefined sets the Bytes to undefined in place, which is the same as assigning NewBytesUndefined().
+func (s *Bytes) SetUndefined() {
+	*s = NewBytesUndefined()
+}
+
+// Validate validates the Bytes against the rules, see Rule.
+func (s Bytes) Validate(rules ...Rule) error {
+	return validate(s, rules)
+}
+
+// Hash returns a hash of the state and the value, which can be used for change detection, see HashStruct.
+func (s Bytes) Hash() uint64 {
+	return hashBinary(s)
+}
+
 // MarshalJSON implements the json Marshaler interface.
 //
 // See: https://pkg.go.dev/encoding/json#Marshaler
-func (s Int16) MarshalJSON() ([]byte, error) {
+func (s Bytes) MarshalJSON() ([]byte, error) {
 	if s.IsNil() {
 		return nullBytes, nil
 	}
 
-	jsonBytes, err := json.Marshal(s.underlying)
+	jsonBytes, err := json.Marshal(s.String())
 	if err != nil {
 		return nil, errors.Wrap(err, s.String())
 	}
@@ -1023,15 +2130,22 @@ func (s Int16) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements the json Unmarshaler interface.
 //
 // See: https://pkg.go.dev/encoding/json#Unmarshaler
-func (s *Int16) UnmarshalJSON(d []byte) error {
+func (s *Bytes) UnmarshalJSON(d []byte) error {
 	s.isNil = isNullBytes(d)
 	s.isDefined = true
 
 	if s.isNil {
+		s.underlying = nil
 		return nil
 	}
 
-	err := json.Unmarshal(d, &s.underlying)
+	var str string
+	err := json.Unmarshal(d, &str)
+	if err != nil {
+		return err
+	}
+
+	s.underlying, err = base64.StdEncoding.DecodeString(str)
 	if err != nil {
 		return err
 	}
@@ -1039,181 +2153,120 @@ func (s *Int16) UnmarshalJSON(d []byte) error {
 	return nil
 }
 
-// Scan assigns a value from a database driver and implements the sql Scanner interface.
+// MarshalYAML implements the yaml Marshaler interface.
 //
-// See https://pkg.go.dev/database/sql#Scanner
-func (s *Int16) Scan(value interface{}) error {
-	s.isNil = (nil == value)
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Marshaler
+func (s Bytes) MarshalYAML() (interface{}, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+
+	return s.String(), nil
+}
+
+// UnmarshalYAML implements the yaml Unmarshaler interface.
+//
+// Note that yaml.v3 does not call UnmarshalYAML for null values,
+// so a null in the YAML input leaves the value undefined.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Unmarshaler
+func (s *Bytes) UnmarshalYAML(value *yaml.Node) error {
+	s.isNil = false
 	s.isDefined = true
 
-	if s.isNil {
-		s.underlying = 0
-		return nil
+	var str string
+	err := value.Decode(&str)
+	if err != nil {
+		return err
 	}
 
-	return convert.ConvertAssign(&s.underlying, value)
+	s.underlying, err = base64.StdEncoding.DecodeString(str)
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
 
-// Value implements the driver Valuer interface.
+// MarshalBinary implements the encoding BinaryMarshaler interface,
+// the value is encoded as a state byte (undefined, nil or defined) followed by the value.
 //
-// See https://pkg.go.dev/database/sql/driver#Valuer
-func (s Int16) Value() (driver.Value, error) {
+// See: https://pkg.go.dev/encoding#BinaryMarshaler
+func (s Bytes) MarshalBinary() ([]byte, error) {
 	if s.IsNil() {
-		return nil, nil
+		return marshalBinaryNil(s.isDefined), nil
 	}
-	return int64(s.underlying), nil
+
+	return append([]byte{binaryDefined}, s.underlying...), nil
 }
 
-// Int64 is used to represent 64-bit integers.
-type Int64 struct {
-	underlying int64
-	isDefined  bool
-	isNil      bool
-}
-
-// NewInt64 creates a new Int64 object.
-func NewInt64(underlying int64) Int64 {
-	return Int64{
-		underlying: underlying,
-		isDefined:  true,
-		isNil:      false,
-	}
-}
-
-// NewInt64FromPtr creates a new Int64 object from a pointer.
-func NewInt64FromPtr(underlying *int64) Int64 {
-	if underlying != nil {
-		return NewInt64(*underlying)
-	}
-
-	return Int64{
-		isDefined: true,
-		isNil:     true,
-	}
-}
-
-// NewInt64Undefined creates a new undefined Int64 object.
-func NewInt64Undefined() Int64 {
-	return Int64{}
-}
-
-func Int64FromStringPtr(strPtr *string) (Int64, error) {
-	if strPtr == nil {
-		return NewInt64FromPtr(nil), nil
-	}
-
-	return Int64FromString(*strPtr)
-}
-
-func Int64FromString(str string) (Int64, error) {
-	if str == "" {
-		return NewInt64FromPtr(nil), nil
+// UnmarshalBinary implements the encoding BinaryUnmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (s *Bytes) UnmarshalBinary(d []byte) error {
+	isDefined, isNil, payload, err := unmarshalBinaryState(d)
+	if err != nil {
+		return err
 	}
 
-	parsed, err := strconv.ParseInt(strings.TrimSpace(str), 10, 64)
-	underlying := int64(parsed)
-
-	if err != nil {
-		return Int64{}, err
+	var underlying []byte
+	if isDefined && !isNil {
+		underlying = bytes.Clone(payload)
 	}
 
-	return Int64{
+	*s = Bytes{
 		underlying: underlying,
-		isDefined:  true,
-		isNil:      false,
-	}, nil
-}
-
-// String output Int64
-func (s Int64) String() string {
-	// If the value is nil we return an empty string
-	if s.IsNil() {
-		return ""
+		isDefined:  isDefined,
+		isNil:      isNil,
 	}
 
-	return fmt.Sprintf("%d", s.underlying)
-}
-
-// Int64 returns the int64 value.
-func (s Int64) Int64() int64 {
-	return s.underlying
+	return nil
 }
 
-// Int64Ptr returns the int64 value as a pointer.
-func (s Int64) Int64Ptr() *int64 {
+// EncodeMsgpack implements the msgpack CustomEncoder interface,
+// nil and undefined values are encoded as an extension type to keep the state.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomEncoder
+func (s Bytes) EncodeMsgpack(enc *msgpack.Encoder) error {
 	if s.IsNil() {
-		return nil
+		return encodeMsgpackNil(enc, s.isDefined)
 	}
-	return &s.underlying
-}
 
-// IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
-func (s Int64) IsDefined() bool {
-	return s.isDefined
+	return enc.EncodeBytes(s.underlying)
 }
 
-// IsNil returns true if the value is nil or undefined.
-func (s Int64) IsNil() bool {
-	// if the value is undefined, it is nil even though "isNil" will be set to false
-	if !s.isDefined {
-		return true
+// DecodeMsgpack implements the msgpack CustomDecoder interface.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomDecoder
+func (s *Bytes) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isDefined, isNil, err := decodeMsgpackState(dec)
+	if err != nil {
+		return err
 	}
 
-	return s.isNil
-}
-
-// IsZero checks if Int64 is nil, which is specifically used by sqlboiler queries
-func (s Int64) IsZero() bool { return s.IsNil() }
+	if !isDefined || isNil {
+		*s = Bytes{
+			isDefined: isDefined,
+			isNil:     isNil,
+		}
 
-// Ptr returns the pointer for Int64, but returns nil if undefined.
-func (s Int64) Ptr() *Int64 {
-	if !s.isDefined {
 		return nil
 	}
 
-	return &s
-}
-
-// Val returns the value of a Int64-pointer,
-// will return an undefined Int64 if the pointer is nil.
-func (s *Int64) Val() Int64 {
-	if s == nil {
-		return NewInt64FromPtr(nil)
-	}
-
-	return *s
-}
-
-// MarshalJSON implements the json Marshaler interface.
-//
-// See: https://pkg.go.dev/encoding/json#Marshaler
-func (s Int64) MarshalJSON() ([]byte, error) {
-	if s.IsNil() {
-		return nullBytes, nil
-	}
-
-	jsonBytes, err := json.Marshal(s.underlying)
+	underlying, err := dec.DecodeBytes()
 	if err != nil {
-		return nil, errors.Wrap(err, s.String())
+		return err
 	}
 
-	return jsonBytes, nil
-}
-
-// UnmarshalJSON implements the json Unmarshaler interface.
-//
-// See: https://pkg.go.dev/encoding/json#Unmarshaler
-func (s *Int64) UnmarshalJSON(d []byte) error {
-	s.isNil = isNullBytes(d)
-	s.isDefined = true
-
-	if s.isNil {
-		return nil
+	// An empty value is not nil
+	if underlying == nil {
+		underlying = []byte{}
 	}
 
-	err := json.Unmarshal(d, &s.underlying)
-	if err != nil {
-		return err
+	*s = Bytes{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
 	}
 
 	return nil
@@ -1222,116 +2275,195 @@ func (s *Int64) UnmarshalJSON(d []byte) error {
 // Scan assigns a value from a database driver and implements the sql Scanner interface.
 //
 // See https://pkg.go.dev/database/sql#Scanner
-func (s *Int64) Scan(value interface{}) error {
+func (s *Bytes) Scan(value interface{}) error {
 	s.isNil = (nil == value)
 	s.isDefined = true
 
 	if s.isNil {
-		s.underlying = 0
+		s.underlying = nil
 		return nil
 	}
 
-	return convert.ConvertAssign(&s.underlying, value)
+	switch v := value.(type) {
+	case []byte:
+		// The driver may reuse the buffer, so the bytes are copied
+		s.underlying = append([]byte{}, v...)
+		return nil
+
+	case string:
+		s.underlying = []byte(v)
+		return nil
+
+	default:
+		return errors.New(fmt.Sprintf("incompatible type for bytes: %T", value))
+	}
 }
 
 // Value implements the driver Valuer interface.
 //
 // See https://pkg.go.dev/database/sql/driver#Valuer
-func (s Int64) Value() (driver.Value, error) {
+func (s Bytes) Value() (driver.Value, error) {
 	if s.IsNil() {
 		return nil, nil
 	}
-	return int64(s.underlying), nil
+
+	if s.underlying == nil {
+		return []byte{}, nil
+	}
+
+	return s.underlying, nil
 }
 
-// JSON is used to represent JSON data.
-type JSON struct {
-	underlying json.RawMessage
+// Color is used to represent hex colors, it is normalized to lowercase "#rrggbb",
+// or "#rrggbbaa" if the color has an alpha channel.
+type Color struct {
+	underlying string
 	isDefined  bool
 	isNil      bool
 }
 
-// NewJSON creates a new JSON object.
-func NewJSON(underlying json.RawMessage) JSON {
-	return JSON{
-		underlying: underlying,
+// NewColor creates a new Color object, the color is normalized if it is valid and lower cased otherwise.
+// Use ColorFromString or MustColorFromString to validate the color.
+func NewColor(underlying string) Color {
+	normalized, err := normalizeColor(underlying)
+	if err != nil {
+		normalized = strings.ToLower(underlying)
+	}
+
+	return Color{
+		underlying: normalized,
 		isDefined:  true,
 		isNil:      false,
 	}
 }
 
-// NewJSONFromPtr creates a new JSON object from a pointer.
-func NewJSONFromPtr(underlying *json.RawMessage) JSON {
+// NewColorFromPtr creates a new Color object from a pointer.
+func NewColorFromPtr(underlying *string) Color {
 	if underlying != nil {
-		return NewJSON(*underlying)
+		return NewColor(*underlying)
 	}
 
-	return JSON{
+	return Color{
 		isDefined: true,
 		isNil:     true,
 	}
 }
 
-// NewJSONUndefined creates a new undefined JSON object.
-func NewJSONUndefined() JSON {
-	return JSON{}
+// NewColorUndefined creates a new undefined Color object.
+func NewColorUndefined() Color {
+	return Color{}
 }
 
-func JSONFromStringPtr(strPtr *string) (JSON, error) {
+func ColorFromStringPtr(strPtr *string) (Color, error) {
 	if strPtr == nil {
-		return NewJSONFromPtr(nil), nil
+		return NewColorFromPtr(nil), nil
 	}
 
-	return JSONFromString(*strPtr)
+	return ColorFromString(*strPtr)
 }
 
-func JSONFromString(str string) (JSON, error) {
+// ColorFromString parses a color in the format "#rgb", "#rrggbb" or "#rrggbbaa".
+func ColorFromString(str string) (Color, error) {
 	if str == "" {
-		return NewJSONFromPtr(nil), nil
+		return NewColorFromPtr(nil), nil
 	}
 
-	underlying, err := json.Marshal(strings.TrimSpace(str))
+	underlying, err := normalizeColor(str)
 	if err != nil {
-		return JSON{}, err
+		return Color{}, err
 	}
 
-	return JSON{
+	return Color{
 		underlying: underlying,
 		isDefined:  true,
 		isNil:      false,
 	}, nil
 }
 
-// String output JSON
-func (s JSON) String() string {
-	// If the value is nil we return an empty string
+// MustColorFromString is like ColorFromString but panics if the string cannot be parsed, like uuid.MustParse.
+// It is meant for tests and package-level variables, e.g. MustColorFromString("#ffaa00"), never for untrusted input.
+func MustColorFromString(str string) Color {
+	return must(ColorFromString(str))
+}
+
+// normalizeColor validates the color and returns it as lowercase "#rrggbb" or "#rrggbbaa".
+func normalizeColor(str string) (string, error) {
+	color := strings.ToLower(strings.TrimSpace(str))
+
+	if !strings.HasPrefix(color, "#") {
+		return "", errors.New("invalid color: " + str)
+	}
+
+	digits := color[1:]
+	for _, r := range digits {
+		if !(r >= '0' && r <= '9' || r >= 'a' && r <= 'f') {
+			return "", errors.New("invalid color: " + str)
+		}
+	}
+
+	switch len(digits) {
+	case 3:
+		return string([]byte{'#', digits[0], digits[0], digits[1], digits[1], digits[2], digits[2]}), nil
+	case 6, 8:
+		return color, nil
+	default:
+		return "", errors.New("invalid color: " + str)
+	}
+}
+
+// String returns the color as "#rrggbb" or "#rrggbbaa".
+func (s Color) String() string {
+	return s.underlying
+}
+
+// CSVValue returns the value for a CSV field, which is the same as String and an empty string for nil.
+func (s Color) CSVValue() string {
 	if s.IsNil() {
 		return ""
 	}
 
-	return string(s.underlying)
+	return s.String()
 }
 
-// JSON returns the json.RawMessage value.
-func (s JSON) JSON() json.RawMessage {
+// Color returns the string value.
+func (s Color) Color() string {
 	return s.underlying
 }
 
-// JSONPtr returns the json.RawMessage value as a pointer.
-func (s JSON) JSONPtr() *json.RawMessage {
+// ColorPtr returns the string value as a pointer.
+func (s Color) ColorPtr() *string {
 	if s.IsNil() {
 		return nil
 	}
 	return &s.underlying
 }
 
+// RGBA returns the red, green, blue and alpha channels of the color,
+// the alpha is 255 if the color has no alpha channel. All channels are 0 for nil or invalid colors.
+func (s Color) RGBA() (r, g, b, a uint8) {
+	if s.IsNil() || len(s.underlying) < 7 {
+		return 0, 0, 0, 0
+	}
+
+	channels, err := hex.DecodeString(s.underlying[1:])
+	if err != nil {
+		return 0, 0, 0, 0
+	}
+
+	if len(channels) == 4 {
+		return channels[0], channels[1], channels[2], channels[3]
+	}
+
+	return channels[0], channels[1], channels[2], 255
+}
+
 // IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
-func (s JSON) IsDefined() bool {
+func (s Color) IsDefined() bool {
 	return s.isDefined
 }
 
 // IsNil returns true if the value is nil or undefined.
-func (s JSON) IsNil() bool {
+func (s Color) IsNil() bool {
 	// if the value is undefined, it is nil even though "isNil" will be set to false
 	if !s.isDefined {
 		return true
@@ -1340,11 +2472,11 @@ func (s JSON) IsNil() bool {
 	return s.isNil
 }
 
-// IsZero checks if JSON is nil, which is specifically used by sqlboiler queries
-func (s JSON) IsZero() bool { return s.IsNil() }
+// IsZero checks if Color is nil, which is specifically used by sqlboiler queries
+func (s Color) IsZero() bool { return s.IsNil() }
 
-// Ptr returns the pointer for JSON, but returns nil if undefined.
-func (s JSON) Ptr() *JSON {
+// Ptr returns the pointer for Color, but returns nil if undefined.
+func (s Color) Ptr() *Color {
 	if !s.isDefined {
 		return nil
 	}
@@ -1352,20 +2484,45 @@ func (s JSON) Ptr() *JSON {
 	return &s
 }
 
-// Val returns the value of a JSON-pointer,
-// will return an undefined JSON if the pointer is nil.
-func (s *JSON) Val() JSON {
+// Val returns the value of a Color-pointer,
+// will return an undefined Color if the pointer is nil.
+func (s *Color) Val() Color {
 	if s == nil {
-		return NewJSONFromPtr(nil)
+		return NewColorFromPtr(nil)
 	}
 
 	return *s
 }
 
+// Set sets the Color to the value in place, which is the same as assigning NewColor(v).
+func (s *Color) Set(v string) {
+	*s = NewColor(v)
+}
+
+// SetNil sets the Color to nil in place, which is the same as assigning NewColorFromPtr(nil), so it is still defined.
+func (s *Color) SetNil() {
+	*s = NewColorFromPtr(nil)
+}
+
+// SetUndefined sets the Color to undefined in place, which is the same as assigning NewColorUndefined().
+func (s *Color) SetUndefined() {
+	*s = NewColorUndefined()
+}
+
+// Validate validates the Color against the rules, see Rule.
+func (s Color) Validate(rules ...Rule) error {
+	return validate(s, rules)
+}
+
+// Hash returns a hash of the state and the value, which can be used for change detection, see HashStruct.
+func (s Color) Hash() uint64 {
+	return hashBinary(s)
+}
+
 // MarshalJSON implements the json Marshaler interface.
 //
 // See: https://pkg.go.dev/encoding/json#Marshaler
-func (s JSON) MarshalJSON() ([]byte, error) {
+func (s Color) MarshalJSON() ([]byte, error) {
 	if s.IsNil() {
 		return nullBytes, nil
 	}
@@ -1381,7 +2538,7 @@ func (s JSON) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements the json Unmarshaler interface.
 //
 // See: https://pkg.go.dev/encoding/json#Unmarshaler
-func (s *JSON) UnmarshalJSON(d []byte) error {
+func (s *Color) UnmarshalJSON(d []byte) error {
 	s.isNil = isNullBytes(d)
 	s.isDefined = true
 
@@ -1389,365 +2546,327 @@ func (s *JSON) UnmarshalJSON(d []byte) error {
 		return nil
 	}
 
-	err := json.Unmarshal(d, &s.underlying)
+	var str string
+	err := json.Unmarshal(d, &str)
 	if err != nil {
 		return err
 	}
 
-	return nil
-}
-
-func (s *JSON) Marshal(obj interface{}) error {
-	res, err := json.Marshal(obj)
+	s.underlying, err = normalizeColor(str)
 	if err != nil {
 		return err
 	}
 
-	s.isDefined = true
-	s.isNil = isNullBytes(res)
-	s.underlying = res
 	return nil
 }
 
-// RichText is used to represent rich text.
-type RichText struct {
-	underlying string
-	isDefined  bool
-	isNil      bool
+// MarshalYAML implements the yaml Marshaler interface.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Marshaler
+func (s Color) MarshalYAML() (interface{}, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+
+	return s.underlying, nil
 }
 
-// NewRichText creates a new RichText object.
-func NewRichText(underlying string) RichText {
-	return RichText{
-		underlying: underlying,
-		isDefined:  true,
-		isNil:      false,
-	}
-}
-
-// NewRichTextFromPtr creates a new RichText object from a pointer.
-func NewRichTextFromPtr(underlying *string) RichText {
-	if underlying != nil {
-		return NewRichText(*underlying)
-	}
-
-	return RichText{
-		isDefined: true,
-		isNil:     true,
-	}
-}
-
-// NewRichTextUndefined creates a new undefined RichText object.
-func NewRichTextUndefined() RichText {
-	return RichText{}
-}
-
-func RichTextFromStringPtr(strPtr *string) (RichText, error) {
-	if strPtr == nil {
-		return NewRichTextFromPtr(nil), nil
-	}
-
-	return RichTextFromString(*strPtr)
-}
-
-func RichTextFromString(str string) (RichText, error) {
-	if str == "" {
-		return NewRichTextFromPtr(nil), nil
-	}
-
-	var err error
-	underlying := strings.TrimSpace(str)
+// UnmarshalYAML implements the yaml Unmarshaler interface.
+//
+// Note that yaml.v3 does not call UnmarshalYAML for null values,
+// so a null in the YAML input leaves the value undefined.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Unmarshaler
+func (s *Color) UnmarshalYAML(value *yaml.Node) error {
+	s.isNil = false
+	s.isDefined = true
 
+	var str string
+	err := value.Decode(&str)
 	if err != nil {
-		return RichText{}, err
+		return err
 	}
 
-	return RichText{
-		underlying: underlying,
-		isDefined:  true,
-		isNil:      false,
-	}, nil
-}
-
-// String output RichText
-func (s RichText) String() string {
-	// If the value is nil we return an empty string
-	if s.IsNil() {
-		return ""
+	s.underlying, err = normalizeColor(str)
+	if err != nil {
+		return err
 	}
 
-	return string(s.underlying)
-}
-
-// RichText returns the string value.
-func (s RichText) RichText() string {
-	return s.underlying
+	return nil
 }
 
-// RichTextPtr returns the string value as a pointer.
-func (s RichText) RichTextPtr() *string {
+// MarshalBinary implements the encoding BinaryMarshaler interface,
+// the value is encoded as a state byte (undefined, nil or defined) followed by the value.
+//
+// See: https://pkg.go.dev/encoding#BinaryMarshaler
+func (s Color) MarshalBinary() ([]byte, error) {
 	if s.IsNil() {
-		return nil
-	}
-	return &s.underlying
-}
-
-// IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
-func (s RichText) IsDefined() bool {
-	return s.isDefined
-}
-
-// IsNil returns true if the value is nil or undefined.
-func (s RichText) IsNil() bool {
-	// if the value is undefined, it is nil even though "isNil" will be set to false
-	if !s.isDefined {
-		return true
+		return marshalBinaryNil(s.isDefined), nil
 	}
 
-	return s.isNil
+	return append([]byte{binaryDefined}, s.underlying...), nil
 }
 
-// IsZero checks if RichText is nil, which is specifically used by sqlboiler queries
-func (s RichText) IsZero() bool { return s.IsNil() }
-
-// Ptr returns the pointer for RichText, but returns nil if undefined.
-func (s RichText) Ptr() *RichText {
-	if !s.isDefined {
-		return nil
+// UnmarshalBinary implements the encoding BinaryUnmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (s *Color) UnmarshalBinary(d []byte) error {
+	isDefined, isNil, payload, err := unmarshalBinaryState(d)
+	if err != nil {
+		return err
 	}
 
-	return &s
-}
-
-// Val returns the value of a RichText-pointer,
-// will return an undefined RichText if the pointer is nil.
-func (s *RichText) Val() RichText {
-	if s == nil {
-		return NewRichTextFromPtr(nil)
+	var underlying string
+	if isDefined && !isNil {
+		underlying, err = normalizeColor(string(payload))
+		if err != nil {
+			return err
+		}
 	}
 
-	return *s
-}
-
-// RichTextToLower returns the underlying value of RichText in lower case.
-func RichTextToLower(s RichText) RichText {
-	if !s.IsNil() {
-		s.underlying = strings.ToLower(s.underlying)
+	*s = Color{
+		underlying: underlying,
+		isDefined:  isDefined,
+		isNil:      isNil,
 	}
 
-	return s
+	return nil
 }
 
-// RichTextsToLower returns the underlying values of RichTexts in lower case.
-func RichTextsToLower(s []RichText) []RichText {
-	for i := range s {
-		s[i].underlying = strings.ToLower(s[i].underlying)
+// EncodeMsgpack implements the msgpack CustomEncoder interface,
+// nil and undefined values are encoded as an extension type to keep the state.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomEncoder
+func (s Color) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if s.IsNil() {
+		return encodeMsgpackNil(enc, s.isDefined)
 	}
 
-	return s
+	return enc.EncodeString(s.underlying)
 }
 
-// Text returns the plain text value of the rich text.
-//
-// The method basically converts HTML content to plain text,
-// removing all HTML tags and unescaping HTML entities.
+// DecodeMsgpack implements the msgpack CustomDecoder interface.
 //
-// For example, "<p>Hello my &lt;b&gt;friend&lt;/b&gt;</p>" becomes "Hello my <b>friend</b>".
-func (s RichText) Text() (string, error) {
-	doc, err := html.Parse(strings.NewReader(s.underlying))
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomDecoder
+func (s *Color) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isDefined, isNil, err := decodeMsgpackState(dec)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	// walkNodes recursively traverses the HTML node tree and extracts text from text nodes
-	var walkNodes func(b *bytes.Buffer, n *html.Node) error
-
-	walkNodes = func(b *bytes.Buffer, n *html.Node) error {
-		if n.Type == html.TextNode {
-			_, err := b.WriteString(n.Data)
-			if err != nil {
-				return err
-			}
-		}
-
-		for c := n.FirstChild; c != nil; c = c.NextSibling {
-			if err := walkNodes(b, c); err != nil {
-				return err
-			}
-		}
-
-		// Add double newlines for specific closing tags unless it's the last node
-		if n.Type == html.ElementNode {
-			switch n.Data {
-			case "p", "h1", "h2", "h3", "pre", "ul", "ol":
-				if n.NextSibling != nil || n.Parent.NextSibling != nil {
-					_, err := b.WriteString("\n\n")
-					if err != nil {
-						return err
-					}
-				}
-			}
+	if !isDefined || isNil {
+		*s = Color{
+			isDefined: isDefined,
+			isNil:     isNil,
 		}
 
 		return nil
 	}
 
-	var b bytes.Buffer
-	if err := walkNodes(&b, doc); err != nil {
-		return "", err
-	}
-
-	return strings.TrimSuffix(b.String(), "\n\n"), nil
-}
-
-// MarshalJSON implements the json Marshaler interface.
-//
-// See: https://pkg.go.dev/encoding/json#Marshaler
-func (s RichText) MarshalJSON() ([]byte, error) {
-	if s.IsNil() {
-		return nullBytes, nil
-	}
-
-	text, err := s.Text()
+	str, err := dec.DecodeString()
 	if err != nil {
-		return nil, errors.Wrap(err, "cannot convert to text "+s.underlying)
+		return err
 	}
 
-	richText := struct {
-		Content string `json:"content"`
-		Text    string `json:"text"`
-	}{
-		Content: s.underlying,
-		Text:    text,
+	underlying, err := normalizeColor(str)
+	if err != nil {
+		return err
 	}
 
-	jsonBytes, err := json.Marshal(richText)
-	if err != nil {
-		return nil, errors.Wrap(err, s.underlying)
+	*s = Color{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
 	}
 
-	return jsonBytes, nil
+	return nil
 }
 
-// UnmarshalJSON implements the json Unmarshaler interface.
+// Scan assigns a value from a database driver and implements the sql Scanner interface.
 //
-// See: https://pkg.go.dev/encoding/json#Unmarshaler
-func (s *RichText) UnmarshalJSON(d []byte) error {
-	s.isNil = isNullBytes(d)
+// See https://pkg.go.dev/database/sql#Scanner
+func (s *Color) Scan(value interface{}) error {
+	s.isNil = (nil == value)
 	s.isDefined = true
 
 	if s.isNil {
+		s.underlying = ""
 		return nil
 	}
 
-	richText := struct {
-		Content string `json:"content"` // We only care about the content
-		Text    string `json:"-"`
-	}{}
-
-	err := json.Unmarshal(d, &richText)
+	var str string
+	err := convert.ConvertAssign(&str, value)
 	if err != nil {
 		return err
 	}
 
-	s.underlying = richText.Content
-
-	s.underlying = strings.TrimSpace(s.underlying)
-
-	return nil
-}
-
-// Scan assigns a value from a database driver and implements the sql Scanner interface.
-//
-// See https://pkg.go.dev/database/sql#Scanner
-func (s *RichText) Scan(value interface{}) error {
-	s.isNil = (nil == value)
-	s.isDefined = true
-
-	if s.isNil {
-		return nil
+	s.underlying, err = normalizeColor(str)
+	if err != nil {
+		return err
 	}
 
-	return convert.ConvertAssign(&s.underlying, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
 //
 // See https://pkg.go.dev/database/sql/driver#Valuer
-func (s RichText) Value() (driver.Value, error) {
+func (s Color) Value() (driver.Value, error) {
 	if s.IsNil() {
 		return nil, nil
 	}
 	return s.underlying, nil
 }
 
-// String is used to represent strings.
-type String struct {
-	underlying string
+// Date is used to represent dates according to the ISO 8601 standard.
+type Date struct {
+	underlying time.Time
 	isDefined  bool
 	isNil      bool
 }
 
-// NewString creates a new String object.
-func NewString(underlying string) String {
-	return String{
-		underlying: underlying,
+// NewDate creates a new Date object.
+func NewDate(underlying time.Time) Date {
+	return Date{
+		underlying: underlyingTime(underlying, "2006-01-02"),
 		isDefined:  true,
 		isNil:      false,
 	}
 }
 
-// NewStringFromPtr creates a new String object from a pointer.
-func NewStringFromPtr(underlying *string) String {
+// NewDateFromPtr creates a new Date object from a pointer.
+func NewDateFromPtr(underlying *time.Time) Date {
 	if underlying != nil {
-		return NewString(*underlying)
+		return NewDate(*underlying)
 	}
 
-	return String{
+	return Date{
 		isDefined: true,
 		isNil:     true,
 	}
 }
 
-// NewStringUndefined creates a new undefined String object.
-func NewStringUndefined() String {
-	return String{}
+// NewDateUndefined creates a new undefined Date object.
+func NewDateUndefined() Date {
+	return Date{}
 }
 
-func StringFromStringPtr(strPtr *string) (String, error) {
+func DateFromStringPtr(strPtr *string) (Date, error) {
 	if strPtr == nil {
-		return NewStringFromPtr(nil), nil
+		return NewDateFromPtr(nil), nil
 	}
 
-	return StringFromString(*strPtr)
+	return DateFromString(*strPtr)
 }
 
-func StringFromString(str string) (String, error) {
+// dateLayouts are the layouts used by DateFromString, in the order they are tried.
+var dateLayouts = []string{
+	"2006-01-02",  // YYYY-MM-DD
+	"01-02-06",    // MM-DD-YY, US format short.. Apparently what excel makes dates into.
+	"02-01-06",    // DD-MM-YY, Reverse order from Excelize
+	"06-01-02",    // YY-MM-DD, Can only happen if Year is > 31 so the above check DD-MM-YY has failed
+	"01-02-2006",  // MM-DD-YYYY, US format
+	"02-Jan-2006", // DD-MMM-YYYY, old style Oracle
+	"02-Jan-06",   // DD-MMM-YY, old style Oracle
+	"2006-002",    // YYYY-DDD, ISO 8601 ordinal date
+
+	// Compact layouts without separators are told apart by their length,
+	// 8 digits is always YYYYMMDD and 6 digits is always YYMMDD. The layouts above
+	// all contain separators, so they can never match a compact date.
+	"20060102", // YYYYMMDD
+	"060102",   // YYMMDD
+}
+
+// DateFromString parses the date with the first matching layout, which are tried in this order:
+// YYYY-MM-DD, MM-DD-YY, DD-MM-YY, YY-MM-DD, MM-DD-YYYY, DD-MMM-YYYY, DD-MMM-YY, YYYY-DDD, YYYYMMDD and YYMMDD.
+//
+// Two-digit dates are ambiguous and the US order wins, e.g. "03-04-05" is March 4th 2005,
+// DD-MM-YY is only used when the first number cannot be a month, e.g. "13-04-05" is April 13th 2005.
+// Use DateFromStringWithLayouts when the order of the input is known, e.g. for European input.
+func DateFromString(str string) (Date, error) {
+	return DateFromStringWithLayouts(str, dateLayouts)
+}
+
+// MustDateFromString is like DateFromString but panics if the string cannot be parsed, like uuid.MustParse.
+// It is meant for tests and package-level variables, e.g. MustDateFromString("2024-01-02"), never for untrusted input.
+func MustDateFromString(str string) Date {
+	return must(DateFromString(str))
+}
+
+// DateFromStringWithLayouts parses the date with the first of the layouts that matches, see time.Parse,
+// e.g. DateFromStringWithLayouts("03-04-05", []string{"2006-01-02", "02-01-06"}) is April 3rd 2005.
+// An empty string is parsed as nil.
+func DateFromStringWithLayouts(str string, layouts []string) (Date, error) {
 	if str == "" {
-		return NewStringFromPtr(nil), nil
+		return NewDateFromPtr(nil), nil
 	}
 
-	var err error
-	underlying := strings.TrimSpace(str)
+	for _, layout := range layouts {
+		underlying, err := time.Parse(layout, str)
+		if err != nil {
+			continue
+		}
 
-	if err != nil {
-		return String{}, err
+		return Date{
+			underlying: underlying,
+			isDefined:  true,
+			isNil:      false,
+		}, nil
 	}
 
-	return String{
-		underlying: underlying,
-		isDefined:  true,
-		isNil:      false,
-	}, nil
+	return Date{}, errors.New("invalid date format: " + str)
 }
 
-// String returns the string value.
-func (s String) String() string {
+// DatesFromStrings parses the strings with DateFromString,
+// an error is returned for the first string that cannot be parsed.
+func DatesFromStrings(strs []string) ([]Date, error) {
+	return fromStrings(strs, DateFromString)
+}
+
+// DatesToStrings converts the values to strings with String, a nil value becomes an empty string.
+func DatesToStrings(values []Date) []string {
+	return toStrings(values)
+}
+
+// DateFromOrdinal creates a Date from the year and the day of the year, as in ISO 8601 ordinal dates,
+// where day 1 is January 1st. An error is returned if the day is not in the year, e.g. day 366 in a non-leap year.
+func DateFromOrdinal(year, dayOfYear int) (Date, error) {
+	firstDay := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	daysInYear := time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC).YearDay()
+
+	if dayOfYear < 1 || dayOfYear > daysInYear {
+		return Date{}, errors.New(fmt.Sprintf("day of year out of range for %d: %d", year, dayOfYear))
+	}
+
+	return NewDate(firstDay.AddDate(0, 0, dayOfYear-1)), nil
+}
+
+// String output Date
+func (s Date) String() string {
+	// If the value is nil we return an empty string
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.underlying.Format("2006-01-02")
+}
+
+// CSVValue returns the value for a CSV field, which is the same as String and an empty string for nil.
+func (s Date) CSVValue() string {
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.String()
+}
+
+// Date returns the time.Time value.
+func (s Date) Date() time.Time {
 	return s.underlying
 }
 
-// StringPtr returns the string value as a pointer.
-func (s String) StringPtr() *string {
+// DatePtr returns the time.Time value as a pointer.
+func (s Date) DatePtr() *time.Time {
 	if s.IsNil() {
 		return nil
 	}
@@ -1755,12 +2874,12 @@ func (s String) StringPtr() *string {
 }
 
 // IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
-func (s String) IsDefined() bool {
+func (s Date) IsDefined() bool {
 	return s.isDefined
 }
 
 // IsNil returns true if the value is nil or undefined.
-func (s String) IsNil() bool {
+func (s Date) IsNil() bool {
 	// if the value is undefined, it is nil even though "isNil" will be set to false
 	if !s.isDefined {
 		return true
@@ -1769,11 +2888,11 @@ func (s String) IsNil() bool {
 	return s.isNil
 }
 
-// IsZero checks if String is nil, which is specifically used by sqlboiler queries
-func (s String) IsZero() bool { return s.IsNil() }
+// IsZero checks if Date is nil, which is specifically used by sqlboiler queries
+func (s Date) IsZero() bool { return s.IsNil() }
 
-// Ptr returns the pointer for String, but returns nil if undefined.
-func (s String) Ptr() *String {
+// Ptr returns the pointer for Date, but returns nil if undefined.
+func (s Date) Ptr() *Date {
 	if !s.isDefined {
 		return nil
 	}
@@ -1781,234 +2900,178 @@ func (s String) Ptr() *String {
 	return &s
 }
 
-// Val returns the value of a String-pointer,
-// will return an undefined String if the pointer is nil.
-func (s *String) Val() String {
+// Val returns the value of a Date-pointer,
+// will return an undefined Date if the pointer is nil.
+func (s *Date) Val() Date {
 	if s == nil {
-		return NewStringFromPtr(nil)
+		return NewDateFromPtr(nil)
 	}
 
 	return *s
 }
 
-// StringToLower returns the underlying value of String in lower case.
-func StringToLower(s String) String {
-	if !s.IsNil() {
-		s.underlying = strings.ToLower(s.underlying)
-	}
-
-	return s
+// Set sets the Date to the value in place, which is the same as assigning NewDate(v).
+func (s *Date) Set(v time.Time) {
+	*s = NewDate(v)
 }
 
-// StringsToLower returns the underlying values of Strings in lower case.
-func StringsToLower(s []String) []String {
-	for i := range s {
-		s[i].underlying = strings.ToLower(s[i].underlying)
-	}
-
-	return s
+// SetNil sets the Date to nil in place, which is the same as assigning NewDateFromPtr(nil), so it is still defined.
+func (s *Date) SetNil() {
+	*s = NewDateFromPtr(nil)
 }
 
-// MarshalJSON implements the json Marshaler interface.
-//
-// See: https://pkg.go.dev/encoding/json#Marshaler
-func (s String) MarshalJSON() ([]byte, error) {
-	if s.IsNil() {
-		return nullBytes, nil
-	}
-
-	jsonBytes, err := json.Marshal(s.underlying)
-	if err != nil {
-		return nil, errors.Wrap(err, s.String())
-	}
-
-	return jsonBytes, nil
+// SetUndefined sets the Date to undefined in place, which is the same as assigning NewDateUndefined().
+func (s *Date) SetUndefined() {
+	*s = NewDateUndefined()
 }
 
-// UnmarshalJSON implements the json Unmarshaler interface.
-//
-// See: https://pkg.go.dev/encoding/json#Unmarshaler
-func (s *String) UnmarshalJSON(d []byte) error {
-	s.isNil = isNullBytes(d)
-	s.isDefined = true
-
-	if s.isNil {
-		return nil
-	}
-
-	err := json.Unmarshal(d, &s.underlying)
-	if err != nil {
-		return err
-	}
+// Validate validates the Date against the rules, see Rule.
+func (s Date) Validate(rules ...Rule) error {
+	return validate(s, rules)
+}
 
-	s.underlying = strings.TrimSpace(s.underlying)
+// Compare implements the Ordered interface, nil values sort before all non-nil values.
+func (s Date) Compare(other any) (int, error) {
+	return compareAny(s, other, func(a, b Date) int {
+		return underlyingTime(a.underlying, "2006-01-02").Compare(underlyingTime(b.underlying, "2006-01-02"))
+	})
+}
 
-	return nil
+// Hash returns a hash of the state and the value, which can be used for change detection, see HashStruct.
+func (s Date) Hash() uint64 {
+	return hashBinary(s)
 }
 
-// Scan assigns a value from a database driver and implements the sql Scanner interface.
+// SameMonthDay returns true if both dates have the same month and day, regardless of the year.
 //
-// See https://pkg.go.dev/database/sql#Scanner
-func (s *String) Scan(value interface{}) error {
-	s.isNil = (nil == value)
-	s.isDefined = true
-
-	if s.isNil {
-		s.underlying = ""
-		return nil
+// February 29th only matches February 29th, so callers that need to handle leap days
+// in non-leap years (e.g. birthdays) have to compare against February 28th or March 1st themselves.
+// If any of the dates is nil, false is returned.
+func (s Date) SameMonthDay(other Date) bool {
+	if s.IsNil() || other.IsNil() {
+		return false
 	}
 
-	return convert.ConvertAssign(&s.underlying, value)
+	return s.underlying.Month() == other.underlying.Month() && s.underlying.Day() == other.underlying.Day()
 }
 
-// Value implements the driver Valuer interface.
+// AddDate returns a new Date with the years, months and days added.
+// A nil or undefined Date is returned as is.
 //
-// See https://pkg.go.dev/database/sql/driver#Valuer
-func (s String) Value() (driver.Value, error) {
+// See https://pkg.go.dev/time#Time.AddDate for how overflowing dates are normalized.
+func (s Date) AddDate(years, months, days int) Date {
 	if s.IsNil() {
-		return nil, nil
+		return s
 	}
-	return s.underlying, nil
-}
-
-// Time is used to represent a times by the format "HH:MM"
-type Time struct {
-	underlying time.Time
-	isDefined  bool
-	isNil      bool
-}
 
-// NewTime creates a new Time object.
-func NewTime(underlying time.Time) Time {
-	return Time{
-		underlying: underlyingTime(underlying, "15:04"),
-		isDefined:  true,
-		isNil:      false,
-	}
+	return NewDate(underlyingTime(s.underlying, "2006-01-02").AddDate(years, months, days))
 }
 
-// NewTimeFromPtr creates a new Time object from a pointer.
-func NewTimeFromPtr(underlying *time.Time) Time {
-	if underlying != nil {
-		return NewTime(*underlying)
+// DaysBetween returns the number of days from the date to the other date,
+// which is negative if the other date is before the date.
+// If any of the dates is nil, 0 is returned.
+func (s Date) DaysBetween(other Date) int {
+	if s.IsNil() || other.IsNil() {
+		return 0
 	}
 
-	return Time{
-		isDefined: true,
-		isNil:     true,
-	}
-}
+	// Count whole calendar days between the UTC midnights rather than using
+	// time.Duration, which saturates for spans longer than about 292 years.
+	from := underlyingTime(s.underlying, "2006-01-02").Unix() / 86400
+	to := underlyingTime(other.underlying, "2006-01-02").Unix() / 86400
 
-// NewTimeUndefined creates a new undefined Time object.
-func NewTimeUndefined() Time {
-	return Time{}
+	return int(to - from)
 }
 
-func TimeFromStringPtr(strPtr *string) (Time, error) {
-	if strPtr == nil {
-		return NewTimeFromPtr(nil), nil
+// Before returns true if the date is before the other date.
+// If any of the dates is nil, false is returned.
+func (s Date) Before(other Date) bool {
+	if s.IsNil() || other.IsNil() {
+		return false
 	}
 
-	return TimeFromString(*strPtr)
+	return s.DaysBetween(other) > 0
 }
 
-func TimeFromString(str string) (Time, error) {
-	if str == "" {
-		return NewTimeFromPtr(nil), nil
-	}
-
-	underlying, err := time.Parse("15:04", strings.TrimSpace(str))
-	if err != nil {
-		return Time{}, err
+// After returns true if the date is after the other date.
+// If any of the dates is nil, false is returned.
+func (s Date) After(other Date) bool {
+	if s.IsNil() || other.IsNil() {
+		return false
 	}
 
-	return Time{
-		underlying: underlying,
-		isDefined:  true,
-		isNil:      false,
-	}, nil
+	return s.DaysBetween(other) < 0
 }
 
-// String output Time
-func (s Time) String() string {
-	// If the value is nil we return an empty string
-	if s.IsNil() {
-		return ""
+// Equal returns true if both dates are the same day, or if both dates are nil.
+func (s Date) Equal(other Date) bool {
+	if s.IsNil() || other.IsNil() {
+		return s.IsNil() && other.IsNil()
 	}
 
-	return s.underlying.Format("15:04")
+	return s.DaysBetween(other) == 0
 }
 
-// Time returns the time.Time value.
-func (s Time) Time() time.Time {
-	return s.underlying
+// MinDate returns the earliest of the dates, nil values are skipped.
+// If there are no non-nil values a nil Date is returned.
+func MinDate(values []Date) Date {
+	return extreme(values, func(a, b Date) bool { return a.Before(b) })
 }
 
-// TimePtr returns the time.Time value as a pointer.
-func (s Time) TimePtr() *time.Time {
+// MaxDate returns the latest of the dates, nil values are skipped.
+// If there are no non-nil values a nil Date is returned.
+func MaxDate(values []Date) Date {
+	return extreme(values, func(a, b Date) bool { return a.After(b) })
+}
+
+// Clamp returns the Date bounded by low and high, where a nil bound is unbounded.
+// A nil Date is returned as is.
+func (s Date) Clamp(low, high Date) Date {
 	if s.IsNil() {
-		return nil
+		return s
 	}
-	return &s.underlying
-}
 
-// IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
-func (s Time) IsDefined() bool {
-	return s.isDefined
-}
+	if !low.IsNil() && s.Before(low) {
+		return low
+	}
 
-// IsNil returns true if the value is nil or undefined.
-func (s Time) IsNil() bool {
-	// if the value is undefined, it is nil even though "isNil" will be set to false
-	if !s.isDefined {
-		return true
+	if !high.IsNil() && s.After(high) {
+		return high
 	}
 
-	return s.isNil
+	return s
 }
 
-// IsZero checks if Time is nil, which is specifically used by sqlboiler queries
-func (s Time) IsZero() bool { return s.IsNil() }
-
-// Ptr returns the pointer for Time, but returns nil if undefined.
-func (s Time) Ptr() *Time {
-	if !s.isDefined {
+// NotInFuture returns an error if the date is after the current date in the given location,
+// which is useful to validate e.g. birthdates. A nil Date is considered valid.
+func (s Date) NotInFuture(location *time.Location) error {
+	if s.IsNil() {
 		return nil
 	}
 
-	return &s
-}
-
-// Val returns the value of a Time-pointer,
-// will return an undefined Time if the pointer is nil.
-func (s *Time) Val() Time {
-	if s == nil {
-		return NewTimeFromPtr(nil)
+	today := NewDate(Now().In(location))
+	if s.After(today) {
+		return errors.New(fmt.Sprintf("date %s is in the future, today is %s", s.String(), today.String()))
 	}
 
-	return *s
+	return nil
 }
 
 // MarshalJSON implements the json Marshaler interface.
 //
 // See: https://pkg.go.dev/encoding/json#Marshaler
-func (s Time) MarshalJSON() ([]byte, error) {
+func (s Date) MarshalJSON() ([]byte, error) {
 	if s.IsNil() {
 		return nullBytes, nil
 	}
 
-	jsonBytes, err := json.Marshal(s.underlying.Format("15:04"))
-	if err != nil {
-		return nil, errors.Wrap(err, s.String())
-	}
-
-	return jsonBytes, nil
+	return quoteTime(s.underlying, "2006-01-02"), nil
 }
 
 // UnmarshalJSON implements the json Unmarshaler interface.
 //
 // See: https://pkg.go.dev/encoding/json#Unmarshaler
-func (s *Time) UnmarshalJSON(d []byte) error {
+func (s *Date) UnmarshalJSON(d []byte) error {
 	s.isNil = isNullBytes(d)
 	s.isDefined = true
 
@@ -2022,7 +3085,7 @@ func (s *Time) UnmarshalJSON(d []byte) error {
 		return err
 	}
 
-	s.underlying, err = time.Parse("15:04", str)
+	s.underlying, err = time.Parse("2006-01-02", str)
 	if err != nil {
 		return err
 	}
@@ -2030,41 +3093,128 @@ func (s *Time) UnmarshalJSON(d []byte) error {
 	return nil
 }
 
-// Scan assigns a value from a database driver and implements the sql Scanner interface.
+// MarshalYAML implements the yaml Marshaler interface.
 //
-// See https://pkg.go.dev/database/sql#Scanner
-func (s *Time) Scan(value interface{}) error {
-	s.isNil = (nil == value)
-	s.isDefined = true
-
-	if s.isNil {
-		return nil
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Marshaler
+func (s Date) MarshalYAML() (interface{}, error) {
+	if s.IsNil() {
+		return nil, nil
 	}
 
-	val, ok := value.(string)
-	if ok {
-		t, err := time.Parse("15:04:05", val)
-		if err == nil {
-			s.underlying = t
-			return nil
+	return s.underlying.Format("2006-01-02"), nil
+}
+
+// UnmarshalYAML implements the yaml Unmarshaler interface.
+//
+// Note that yaml.v3 does not call UnmarshalYAML for null values,
+// so a null in the YAML input leaves the value undefined.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Unmarshaler
+func (s *Date) UnmarshalYAML(value *yaml.Node) error {
+	s.isNil = false
+	s.isDefined = true
+
+	var str string
+	err := value.Decode(&str)
+	if err != nil {
+		return err
+	}
+
+	s.underlying, err = time.Parse("2006-01-02", str)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary implements the encoding BinaryMarshaler interface,
+// the value is encoded as a state byte (undefined, nil or defined) followed by the value.
+//
+// See: https://pkg.go.dev/encoding#BinaryMarshaler
+func (s Date) MarshalBinary() ([]byte, error) {
+	if s.IsNil() {
+		return marshalBinaryNil(s.isDefined), nil
+	}
+
+	payload, err := s.underlying.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{binaryDefined}, payload...), nil
+}
+
+// UnmarshalBinary implements the encoding BinaryUnmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (s *Date) UnmarshalBinary(d []byte) error {
+	isDefined, isNil, payload, err := unmarshalBinaryState(d)
+	if err != nil {
+		return err
+	}
+
+	var underlying time.Time
+	if isDefined && !isNil {
+		err := underlying.UnmarshalBinary(payload)
+		if err != nil {
+			return err
 		}
 	}
-	return convert.ConvertAssign(&s.underlying, value)
+
+	*s = Date{
+		underlying: underlying,
+		isDefined:  isDefined,
+		isNil:      isNil,
+	}
+
+	return nil
 }
 
-// Value implements the driver Valuer interface.
+// EncodeMsgpack implements the msgpack CustomEncoder interface,
+// nil and undefined values are encoded as an extension type to keep the state.
 //
-// See https://pkg.go.dev/database/sql/driver#Valuer
-func (s Time) Value() (driver.Value, error) {
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomEncoder
+func (s Date) EncodeMsgpack(enc *msgpack.Encoder) error {
 	if s.IsNil() {
-		return nil, nil
+		return encodeMsgpackNil(enc, s.isDefined)
 	}
-	return s.underlying, nil
+
+	return enc.EncodeString(s.String())
 }
 
-// Scan time-of-day from Postgres
-func (s *Time) ScanTime(v pgtype.Time) error {
-	s.isNil = !v.Valid
+// DecodeMsgpack implements the msgpack CustomDecoder interface.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomDecoder
+func (s *Date) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isDefined, isNil, err := decodeMsgpackState(dec)
+	if err != nil {
+		return err
+	}
+
+	if !isDefined || isNil {
+		*s = Date{
+			isDefined: isDefined,
+			isNil:     isNil,
+		}
+
+		return nil
+	}
+
+	str, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+
+	*s, err = DateFromString(str)
+	return err
+}
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (s *Date) Scan(value interface{}) error {
+	s.isNil = (nil == value)
 	s.isDefined = true
 
 	if s.isNil {
@@ -2072,157 +3222,265 @@ func (s *Time) ScanTime(v pgtype.Time) error {
 		return nil
 	}
 
-	// pgtype.Time is microseconds since midnight (in pgx v5).
-	// Convert to a stable time.Time anchored to year 0 (0000-01-01) in UTC,
-	// matching the anchor date used by time.Parse when no date is specified.
-	us := v.Microseconds
-	hours := us / (3600 * 1_000_000)
-	us %= 3600 * 1_000_000
-	minutes := us / (60 * 1_000_000)
-	us %= 60 * 1_000_000
-	seconds := us / 1_000_000
-	micros := us % 1_000_000
+	err := convert.ConvertAssign(&s.underlying, value)
+	if err != nil {
+		return err
+	}
 
-	s.underlying = time.Date(0, 1, 1, int(hours), int(minutes), int(seconds), int(micros*1000), time.UTC)
+	// Normalize the same way as NewDate, keeping the calendar date of the scanned value
+	s.underlying = underlyingTime(s.underlying, "2006-01-02")
 
 	return nil
 }
 
-// Encode time-of-day to Postgres
-func (s Time) TimeValue() (pgtype.Time, error) {
+// Value implements the driver Valuer interface.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (s Date) Value() (driver.Value, error) {
 	if s.IsNil() {
-		return pgtype.Time{
-			Microseconds: 0,
-			Valid:        false,
-		}, nil
+		return nil, nil
 	}
+	return s.underlying, nil
+}
 
-	// Extract time-of-day from your underlying time.Time
-	h, m, sec := s.underlying.Clock()
-	ns := s.underlying.Nanosecond()
-	us := int64(((h*60+m)*60+sec)*1_000_000) + int64(ns/1000)
+// ScanDate implements the [pgtype.DateScanner] interface.
+func (d *Date) ScanDate(v pgtype.Date) error {
+	d.isNil = !v.Valid
+	d.isDefined = true
+	d.underlying = v.Time
 
-	return pgtype.Time{
-		Microseconds: us,
-		Valid:        true,
+	return nil
+}
+
+// DateValue implements the [pgtype.DateValuer] interface.
+func (d Date) DateValue() (pgtype.Date, error) {
+	return pgtype.Date{
+		Time:             d.underlying,
+		InfinityModifier: pgtype.Finite,
+		Valid:            !d.IsNil(),
 	}, nil
 }
 
-// Timestamp is used to represent a timestamps according to the RFC3339 format.
-type Timestamp struct {
+// DatesBetween returns every calendar date from start to end, both inclusive.
+//
+// If start is after end, or if any of the bounds is nil, an empty slice is returned.
+func DatesBetween(start, end Date) []Date {
+	if start.IsNil() || end.IsNil() {
+		return []Date{}
+	}
+
+	from := underlyingTime(start.underlying, "2006-01-02")
+	to := underlyingTime(end.underlying, "2006-01-02")
+
+	dates := []Date{}
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		dates = append(dates, NewDate(d))
+	}
+
+	return dates
+}
+
+// DateRange is a range of dates, e.g. an enrollment period.
+//
+// A nil Start is unbounded in the past and a nil End is unbounded in the future.
+// The End is inclusive by default, set ExclusiveEnd to make the range end the day before End.
+type DateRange struct {
+	Start        Date
+	End          Date
+	ExclusiveEnd bool
+}
+
+// NewDateRange creates a new DateRange with an inclusive end.
+func NewDateRange(start, end Date) DateRange {
+	return DateRange{
+		Start: start,
+		End:   end,
+	}
+}
+
+// lastDay returns the last day in the range, which is nil if the range is unbounded in the future.
+func (r DateRange) lastDay() Date {
+	if r.ExclusiveEnd {
+		return r.End.AddDate(0, 0, -1)
+	}
+
+	return r.End
+}
+
+// IsEmpty returns true if the range contains no days, i.e. the last day is before the Start.
+func (r DateRange) IsEmpty() bool {
+	return r.lastDay().Before(r.Start)
+}
+
+// Contains returns true if the date is within the range, a nil date is never within the range.
+func (r DateRange) Contains(date Date) bool {
+	if date.IsNil() {
+		return false
+	}
+
+	if !r.Start.IsNil() && date.Before(r.Start) {
+		return false
+	}
+
+	lastDay := r.lastDay()
+	if !lastDay.IsNil() && date.After(lastDay) {
+		return false
+	}
+
+	return true
+}
+
+// Overlaps returns true if the ranges have at least one day in common, an empty range overlaps nothing.
+func (r DateRange) Overlaps(other DateRange) bool {
+	if r.IsEmpty() || other.IsEmpty() {
+		return false
+	}
+
+	// Each range must start before the other one ends, a nil Start or last day is always within the other range
+	return !r.Start.After(other.lastDay()) && !other.Start.After(r.lastDay())
+}
+
+// Days returns the number of days in the range, or -1 if the range is unbounded.
+// An empty range has 0 days.
+func (r DateRange) Days() int {
+	lastDay := r.lastDay()
+	if r.Start.IsNil() || lastDay.IsNil() {
+		return -1
+	}
+
+	return max(r.Start.DaysBetween(lastDay)+1, 0)
+}
+
+// DateTime is used to represent an exact instant with its original zone offset and nanoseconds,
+// formatted according to RFC 3339 with fractional seconds, e.g. "2024-01-02T15:04:05.123456789+02:00".
+//
+// Unlike Timestamp the time is kept as is, without converting it to UTC or truncating it to seconds,
+// which makes it suitable for e.g. audit logs where fidelity matters more than a canonical format.
+type DateTime struct {
 	underlying time.Time
 	isDefined  bool
 	isNil      bool
 }
 
-// NewTimestamp creates a new Timestamp object.
-func NewTimestamp(underlying time.Time) Timestamp {
-	return Timestamp{
-		underlying: underlyingTime(underlying, "2006-01-02T15:04:05Z07:00"),
+// dateTimeLayouts are the layouts accepted by DateTimeFromString, the fractional seconds are optional.
+var dateTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+}
+
+// NewDateTime creates a new DateTime object, the time is kept as is.
+func NewDateTime(underlying time.Time) DateTime {
+	return DateTime{
+		underlying: underlying,
 		isDefined:  true,
 		isNil:      false,
 	}
 }
 
-// NewTimestampFromPtr creates a new Timestamp object from a pointer.
-func NewTimestampFromPtr(underlying *time.Time) Timestamp {
+// NewDateTimeFromPtr creates a new DateTime object from a pointer.
+func NewDateTimeFromPtr(underlying *time.Time) DateTime {
 	if underlying != nil {
-		return NewTimestamp(*underlying)
+		return NewDateTime(*underlying)
 	}
 
-	return Timestamp{
+	return DateTime{
 		isDefined: true,
 		isNil:     true,
 	}
 }
 
-// NewTimestampUndefined creates a new undefined Timestamp object.
-func NewTimestampUndefined() Timestamp {
-	return Timestamp{}
+// NewDateTimeUndefined creates a new undefined DateTime object.
+func NewDateTimeUndefined() DateTime {
+	return DateTime{}
 }
 
-func TimestampFromStringPtr(strPtr *string) (Timestamp, error) {
+func DateTimeFromStringPtr(strPtr *string) (DateTime, error) {
 	if strPtr == nil {
-		return NewTimestampFromPtr(nil), nil
+		return NewDateTimeFromPtr(nil), nil
 	}
 
-	return TimestampFromString(*strPtr)
+	return DateTimeFromString(*strPtr)
 }
 
-func TimestampFromString(str string) (Timestamp, error) {
+// DateTimeFromString parses the RFC 3339 string with optional fractional seconds, the zone is required and kept,
+// e.g. "2024-01-02T15:04:05.123+02:00". The date and time may also be separated by a space.
+func DateTimeFromString(str string) (DateTime, error) {
 	if str == "" {
-		return NewTimestampFromPtr(nil), nil
+		return NewDateTimeFromPtr(nil), nil
 	}
 
-	formats := []string{
-		"2006-01-02T15:04:05Z07:00",
-		"2006-01-02 15:04:05Z07:00",
-		"2006-01-02T15:04:05Z",
-		"2006-01-02 15:04:05Z",
-		"2006-01-02T15:04:05",
-		"2006-01-02 15:04:05",
-		"2006-01-02T15:04",
-		"2006-01-02 15:04",
-		"2006-01-02",
-		"1/2/06 15:04",
-		"1/2/06 15:04:05",
-		"1/2/2006 15:04",
-		"1/2/2006 15:04:05",
-	}
+	var err error
 
-	for _, format := range formats {
-		underlying, err := time.Parse(format, strings.TrimSpace(str))
+	for _, layout := range dateTimeLayouts {
+		var underlying time.Time
+
+		underlying, err = time.Parse(layout, strings.TrimSpace(str))
 		if err == nil {
-			return Timestamp{
-				underlying: underlying,
-				isDefined:  true,
-				isNil:      false,
-			}, nil
+			return NewDateTime(underlying), nil
 		}
 	}
 
-	underlying, err := time.Parse("2006-01-02T15:04:05Z07:00", strings.TrimSpace(str))
-	if err != nil {
-		return Timestamp{}, err
-	}
+	return DateTime{}, err
+}
 
-	return Timestamp{
-		underlying: underlying,
-		isDefined:  true,
-		isNil:      false,
-	}, nil
+// MustDateTimeFromString is like DateTimeFromString but panics if the string cannot be parsed, like uuid.MustParse.
+// It is meant for tests and package-level variables, e.g. MustDateTimeFromString("2024-01-02T15:04:05.123+02:00"),
+// never for untrusted input.
+func MustDateTimeFromString(str string) DateTime {
+	return must(DateTimeFromString(str))
 }
 
-// String output Timestamp
-func (s Timestamp) String() string {
+// String output DateTime
+func (s DateTime) String() string {
 	// If the value is nil we return an empty string
 	if s.IsNil() {
 		return ""
 	}
 
-	return s.underlying.Format("2006-01-02T15:04:05Z07:00")
+	return s.underlying.Format(time.RFC3339Nano)
 }
 
-// Timestamp returns the time.Time value.
-func (s Timestamp) Timestamp() time.Time {
+// CSVValue returns the value for a CSV field, which is the same as String and an empty string for nil.
+func (s DateTime) CSVValue() string {
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.String()
+}
+
+// DateTime returns the time.Time value.
+func (s DateTime) DateTime() time.Time {
 	return s.underlying
 }
 
-// TimestampPtr returns the time.Time value as a pointer.
-func (s Timestamp) TimestampPtr() *time.Time {
+// DateTimePtr returns the time.Time value as a pointer.
+func (s DateTime) DateTimePtr() *time.Time {
 	if s.IsNil() {
 		return nil
 	}
 	return &s.underlying
 }
 
+// Timestamp returns the DateTime as a Timestamp, which is converted to UTC and truncated to seconds.
+func (s DateTime) Timestamp() Timestamp {
+	if s.IsNil() {
+		return Timestamp{
+			isDefined: s.isDefined,
+			isNil:     s.isNil,
+		}
+	}
+
+	return NewTimestamp(s.underlying.UTC())
+}
+
 // IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
-func (s Timestamp) IsDefined() bool {
+func (s DateTime) IsDefined() bool {
 	return s.isDefined
 }
 
 // IsNil returns true if the value is nil or undefined.
-func (s Timestamp) IsNil() bool {
+func (s DateTime) IsNil() bool {
 	// if the value is undefined, it is nil even though "isNil" will be set to false
 	if !s.isDefined {
 		return true
@@ -2231,11 +3489,11 @@ func (s Timestamp) IsNil() bool {
 	return s.isNil
 }
 
-// IsZero checks if Timestamp is nil, which is specifically used by sqlboiler queries
-func (s Timestamp) IsZero() bool { return s.IsNil() }
+// IsZero checks if DateTime is nil, which is specifically used by sqlboiler queries
+func (s DateTime) IsZero() bool { return s.IsNil() }
 
-// Ptr returns the pointer for Timestamp, but returns nil if undefined.
-func (s Timestamp) Ptr() *Timestamp {
+// Ptr returns the pointer for DateTime, but returns nil if undefined.
+func (s DateTime) Ptr() *DateTime {
 	if !s.isDefined {
 		return nil
 	}
@@ -2243,85 +3501,65 @@ func (s Timestamp) Ptr() *Timestamp {
 	return &s
 }
 
-// Val returns the value of a Timestamp-pointer,
-// will return an undefined Timestamp if the pointer is nil.
-func (s *Timestamp) Val() Timestamp {
+// Val returns the value of a DateTime-pointer,
+// will return an undefined DateTime if the pointer is nil.
+func (s *DateTime) Val() DateTime {
 	if s == nil {
-		return NewTimestampFromPtr(nil)
+		return NewDateTimeFromPtr(nil)
 	}
 
 	return *s
 }
 
-func (t Timestamp) After(other Timestamp) bool {
-	return t.Timestamp().After(other.Timestamp())
+// Set sets the DateTime to the value in place, which is the same as assigning NewDateTime(v).
+func (s *DateTime) Set(v time.Time) {
+	*s = NewDateTime(v)
 }
 
-func (t Timestamp) Before(other Timestamp) bool {
-	return t.Timestamp().Before(other.Timestamp())
+// SetNil sets the DateTime to nil in place, which is the same as assigning NewDateTimeFromPtr(nil), so it is still defined.
+func (s *DateTime) SetNil() {
+	*s = NewDateTimeFromPtr(nil)
 }
 
-func (t Timestamp) Equal(other Timestamp) bool {
-	return t.Timestamp().Equal(other.Timestamp())
+// SetUndefined sets the DateTime to undefined in place, which is the same as assigning NewDateTimeUndefined().
+func (s *DateTime) SetUndefined() {
+	*s = NewDateTimeUndefined()
 }
 
-// MinutesUntil returns the minutes until the given timestamp
-func (from Timestamp) MinutesUntil(to Timestamp) int {
-	return int(to.Timestamp().Sub(from.Timestamp()).Minutes())
+// Validate validates the DateTime against the rules, see Rule.
+func (s DateTime) Validate(rules ...Rule) error {
+	return validate(s, rules)
 }
 
-func (t Timestamp) Date() Date {
-	return NewDate(t.Timestamp())
+// Compare implements the Ordered interface, nil values sort before all non-nil values
+// and the instants are compared regardless of their zones.
+func (s DateTime) Compare(other any) (int, error) {
+	return compareAny(s, other, func(a, b DateTime) int { return a.underlying.Compare(b.underlying) })
 }
 
-// Returns a new Timestamp with the time set to the start of the day.
-func (s Timestamp) StartOfDay(location *time.Location) Timestamp {
-	return NewTimestamp(time.Date(
-		s.underlying.Year(),
-		s.underlying.Month(),
-		s.underlying.Day(),
-		0,
-		0,
-		0,
-		0,
-		location,
-	))
-}
+// Hash returns a hash of the state and the value, which can be used for change detection, see HashStruct.
+// The instant is hashed in UTC, so the same instant in different zones hashes equal.
+func (s DateTime) Hash() uint64 {
+	s.underlying = s.underlying.UTC()
 
-// Returns a new Timestamp with the time set to the end of the day.
-func (s Timestamp) EndOfDay(location *time.Location) Timestamp {
-	return NewTimestamp(time.Date(
-		s.underlying.Year(),
-		s.underlying.Month(),
-		s.underlying.Day(),
-		23,
-		59,
-		59,
-		0,
-		location,
-	))
+	return hashBinary(s)
 }
 
 // MarshalJSON implements the json Marshaler interface.
 //
 // See: https://pkg.go.dev/encoding/json#Marshaler
-func (s Timestamp) MarshalJSON() ([]byte, error) {
+func (s DateTime) MarshalJSON() ([]byte, error) {
 	if s.IsNil() {
 		return nullBytes, nil
 	}
 
-	jsonBytes, err := json.Marshal(s.underlying.Format("2006-01-02T15:04:05Z07:00"))
-	if err != nil {
-		return nil, errors.Wrap(err, s.String())
-	}
-
-	return jsonBytes, nil
+	return quoteTime(s.underlying, time.RFC3339Nano), nil
 }
 
 // UnmarshalJSON implements the json Unmarshaler interface.
 //
 // See: https://pkg.go.dev/encoding/json#Unmarshaler
-func (s *Timestamp) UnmarshalJSON(d []byte) error {
+func (s *DateTime) UnmarshalJSON(d []byte) error {
 	s.isNil = isNullBytes(d)
 	s.isDefined = true
 
@@ -2335,7 +3573,7 @@ func (s *Timestamp) UnmarshalJSON(d []byte) error {
 		return err
 	}
 
-	s.underlying, err = time.Parse("2006-01-02T15:04:05Z07:00", str)
+	s.underlying, err = time.Parse(time.RFC3339Nano, str)
 	if err != nil {
 		return err
 	}
@@ -2343,10 +3581,137 @@ func (s *Timestamp) UnmarshalJSON(d []byte) error {
 	return nil
 }
 
-// Scan assigns a value from a database driver and implements the sql Scanner interface.
+// MarshalYAML implements the yaml Marshaler interface.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Marshaler
+func (s DateTime) MarshalYAML() (interface{}, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+
+	return s.underlying.Format(time.RFC3339Nano), nil
+}
+
+// UnmarshalYAML implements the yaml Unmarshaler interface.
+//
+// Note that yaml.v3 does not call UnmarshalYAML for null values,
+// so a null in the YAML input leaves the value undefined.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Unmarshaler
+func (s *DateTime) UnmarshalYAML(value *yaml.Node) error {
+	s.isNil = false
+	s.isDefined = true
+
+	var str string
+	err := value.Decode(&str)
+	if err != nil {
+		return err
+	}
+
+	s.underlying, err = time.Parse(time.RFC3339Nano, str)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary implements the encoding BinaryMarshaler interface,
+// the value is encoded as a state byte (undefined, nil or defined) followed by the value,
+// which keeps the nanoseconds and the zone offset.
+//
+// See: https://pkg.go.dev/encoding#BinaryMarshaler
+func (s DateTime) MarshalBinary() ([]byte, error) {
+	if s.IsNil() {
+		return marshalBinaryNil(s.isDefined), nil
+	}
+
+	payload, err := s.underlying.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{binaryDefined}, payload...), nil
+}
+
+// UnmarshalBinary implements the encoding BinaryUnmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (s *DateTime) UnmarshalBinary(d []byte) error {
+	isDefined, isNil, payload, err := unmarshalBinaryState(d)
+	if err != nil {
+		return err
+	}
+
+	var underlying time.Time
+	if isDefined && !isNil {
+		err := underlying.UnmarshalBinary(payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	*s = DateTime{
+		underlying: underlying,
+		isDefined:  isDefined,
+		isNil:      isNil,
+	}
+
+	return nil
+}
+
+// EncodeMsgpack implements the msgpack CustomEncoder interface,
+// nil and undefined values are encoded as an extension type to keep the state.
+//
+// The value is encoded as an RFC 3339 string, since the msgpack timestamp has no zone.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomEncoder
+func (s DateTime) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if s.IsNil() {
+		return encodeMsgpackNil(enc, s.isDefined)
+	}
+
+	return enc.EncodeString(s.underlying.Format(time.RFC3339Nano))
+}
+
+// DecodeMsgpack implements the msgpack CustomDecoder interface.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomDecoder
+func (s *DateTime) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isDefined, isNil, err := decodeMsgpackState(dec)
+	if err != nil {
+		return err
+	}
+
+	if !isDefined || isNil {
+		*s = DateTime{
+			isDefined: isDefined,
+			isNil:     isNil,
+		}
+
+		return nil
+	}
+
+	str, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+
+	underlying, err := time.Parse(time.RFC3339Nano, str)
+	if err != nil {
+		return err
+	}
+
+	*s = NewDateTime(underlying)
+
+	return nil
+}
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface,
+// the time is kept as scanned, text values are parsed with DateTimeFromString.
 //
 // See https://pkg.go.dev/database/sql#Scanner
-func (s *Timestamp) Scan(value interface{}) error {
+func (s *DateTime) Scan(value interface{}) error {
 	s.isNil = (nil == value)
 	s.isDefined = true
 
@@ -2354,137 +3719,201 @@ func (s *Timestamp) Scan(value interface{}) error {
 		return nil
 	}
 
+	if v, ok := value.([]byte); ok {
+		value = string(v)
+	}
+
+	if str, ok := value.(string); ok {
+		parsed, err := DateTimeFromString(str)
+		if err != nil {
+			return err
+		}
+
+		*s = parsed
+
+		return nil
+	}
+
 	return convert.ConvertAssign(&s.underlying, value)
 }
 
-// Value implements the driver Valuer interface.
+// Value implements the driver Valuer interface, the time is passed to the driver as is.
 //
 // See https://pkg.go.dev/database/sql/driver#Valuer
-func (s Timestamp) Value() (driver.Value, error) {
+func (s DateTime) Value() (driver.Value, error) {
 	if s.IsNil() {
 		return nil, nil
 	}
 	return s.underlying, nil
 }
 
-// ScanTimestamp implements the [pgtype.TimestampScanner] interface.
-func (ts *Timestamp) ScanTimestamp(v pgtype.Timestamp) error {
-	ts.isNil = !v.Valid
-	ts.isDefined = true
-	ts.underlying = v.Time
-
-	return nil
-}
-
-// TimestampValue implements the [pgtype.TimestampValuer] interface.
-func (ts Timestamp) TimestampValue() (pgtype.Timestamp, error) {
-	return pgtype.Timestamp{
-		Time:             ts.underlying,
-		InfinityModifier: pgtype.Finite,
-		Valid:            !ts.IsNil(),
-	}, nil
-}
-
-// UUID is used to represent a UUID.
-type UUID struct {
-	underlying uuid.UUID
+// Float64 is used to represent 64-bit floating point numbers.
+type Float64 struct {
+	underlying float64
 	isDefined  bool
 	isNil      bool
 }
 
-// NewRandomUUID generates a new UUID object.
-func NewRandomUUID() UUID {
-	return UUID{
-		underlying: uuid.New(),
-		isDefined:  true,
-		isNil:      false,
-	}
-}
-
-// NewUUID creates a new UUID object.
-func NewUUID(underlying uuid.UUID) UUID {
-	return UUID{
+// NewFloat64 creates a new Float64 object.
+func NewFloat64(underlying float64) Float64 {
+	return Float64{
 		underlying: underlying,
 		isDefined:  true,
 		isNil:      false,
 	}
 }
 
-// NewUUIDFromPtr creates a new UUID object from a pointer.
-func NewUUIDFromPtr(underlying *uuid.UUID) UUID {
+// NewFloat64FromPtr creates a new Float64 object from a pointer.
+func NewFloat64FromPtr(underlying *float64) Float64 {
 	if underlying != nil {
-		return NewUUID(*underlying)
+		return NewFloat64(*underlying)
 	}
 
-	return UUID{
+	return Float64{
 		isDefined: true,
 		isNil:     true,
 	}
 }
 
-// NewUUIDUndefined creates a new undefined UUID object.
-func NewUUIDUndefined() UUID {
-	return UUID{}
+// NewFloat64Undefined creates a new undefined Float64 object.
+func NewFloat64Undefined() Float64 {
+	return Float64{}
 }
 
-func UUIDFromStringPtr(strPtr *string) (UUID, error) {
+// Float64OrDefault returns the underlying value of Float64,
+// or the given default if the Float64 is nil or undefined.
+func Float64OrDefault(s Float64, def float64) float64 {
+	if s.IsNil() {
+		return def
+	}
+
+	return s.underlying
+}
+
+func Float64FromStringPtr(strPtr *string) (Float64, error) {
 	if strPtr == nil {
-		return NewUUIDFromPtr(nil), nil
+		return NewFloat64FromPtr(nil), nil
 	}
 
-	return UUIDFromString(*strPtr)
+	return Float64FromString(*strPtr)
 }
 
-func UUIDFromString(str string) (UUID, error) {
+func Float64FromString(str string) (Float64, error) {
 	if str == "" {
-		return NewUUIDFromPtr(nil), nil
+		return NewFloat64FromPtr(nil), nil
 	}
 
-	underlying, err := uuid.Parse(strings.TrimSpace(str))
+	underlying, err := strconv.ParseFloat(strings.TrimSpace(str), 64)
 	if err != nil {
-		return UUID{}, err
+		return Float64{}, err
 	}
 
-	return UUID{
+	return Float64{
 		underlying: underlying,
 		isDefined:  true,
 		isNil:      false,
 	}, nil
 }
 
-func UUIDsFromStrings(strings []string) []UUID {
-	uuids := make([]UUID, len(strings))
-	for i := range strings {
-		uuids[i] = NewUUID(uuid.MustParse(strings[i]))
-	}
-	return uuids
+// MustFloat64FromString is like Float64FromString but panics if the string cannot be parsed, like uuid.MustParse.
+// It is meant for tests and package-level variables, e.g. MustFloat64FromString("12.5"), never for untrusted input.
+func MustFloat64FromString(str string) Float64 {
+	return must(Float64FromString(str))
 }
 
-func UUIDsToStrings(uuids []UUID) []string {
-	strings := make([]string, len(uuids))
-	for i := range uuids {
-		strings[i] = uuids[i].String()
-	}
-	return strings
+// Float64sFromStrings parses the strings with Float64FromString,
+// an error is returned for the first string that cannot be parsed.
+func Float64sFromStrings(strs []string) ([]Float64, error) {
+	return fromStrings(strs, Float64FromString)
 }
 
-// String output UUID
-func (s UUID) String() string {
+// Float64sToStrings converts the values to strings with String, a nil value becomes an empty string.
+func Float64sToStrings(values []Float64) []string {
+	return toStrings(values)
+}
+
+// float64DecimalSeparator is the decimal separator used by Float64.String, see SetFloat64DecimalSeparator.
+var float64DecimalSeparator = newSetting(".")
+
+// SetFloat64DecimalSeparator sets the decimal separator used by Float64.String, the default is ".".
+//
+// It is meant to be called once at startup, since it changes the output of all Float64 values,
+// but it is safe for concurrent use. Use Float64.Format to choose the separator for a single value.
+func SetFloat64DecimalSeparator(separator string) {
+	float64DecimalSeparator.set(separator)
+}
+
+// Float64FormatOptions decides how a Float64 is formatted by Float64.Format.
+type Float64FormatOptions struct {
+	// DecimalSeparator separates the integer part from the decimals, e.g. "." or ",".
+	DecimalSeparator string
+
+	// Precision is the maximum number of decimals, the value is rounded to the precision
+	// and trailing zeros are removed. A negative precision uses as many decimals as needed.
+	Precision int
+}
+
+// String output Float64, with at most two decimals separated by the separator set with SetFloat64DecimalSeparator.
+func (s Float64) String() string {
+	return s.Format(Float64FormatOptions{
+		DecimalSeparator: float64DecimalSeparator.get(),
+		Precision:        2,
+	})
+}
+
+// CSVValue returns the value for a CSV field, which is an empty string for nil.
+// Unlike String the value is not rounded and the decimal separator is always a dot,
+// regardless of SetFloat64DecimalSeparator. Use CSVOptions.Float64Precision to round the values of MarshalCSVRecord.
+func (s Float64) CSVValue() string {
+	return s.Format(Float64FormatOptions{
+		DecimalSeparator: ".",
+		Precision:        -1,
+	})
+}
+
+// StringLocalized outputs Float64 in the Swedish format, with at most two decimals separated by a comma.
+func (s Float64) StringLocalized() string {
+	return s.Format(Float64FormatOptions{
+		DecimalSeparator: ",",
+		Precision:        2,
+	})
+}
+
+// Format outputs Float64 according to the given options.
+func (s Float64) Format(opts Float64FormatOptions) string {
 	// If the value is nil we return an empty string
 	if s.IsNil() {
 		return ""
 	}
 
-	return s.underlying.String()
+	precision := opts.Precision
+	if precision < 0 {
+		precision = -1
+	}
+
+	formatted := strconv.FormatFloat(s.underlying, 'f', precision, 64)
+
+	// Trim unnecessary zeros
+	if strings.Contains(formatted, ".") {
+		formatted = strings.TrimRight(formatted, "0")
+		formatted = strings.TrimSuffix(formatted, ".")
+	}
+
+	// Negative values rounded to zero shouldn't keep the sign
+	if formatted == "-0" {
+		formatted = "0"
+	}
+
+	return strings.Replace(formatted, ".", opts.DecimalSeparator, 1)
 }
 
-// UUID returns the uuid.UUID value.
-func (s UUID) UUID() uuid.UUID {
+// Float64 returns the float64 value.
+func (s Float64) Float64() float64 {
 	return s.underlying
 }
 
-// UUIDPtr returns the uuid.UUID value as a pointer.
-func (s UUID) UUIDPtr() *uuid.UUID {
+// Float64Ptr returns the float64 value as a pointer.
+func (s Float64) Float64Ptr() *float64 {
 	if s.IsNil() {
 		return nil
 	}
@@ -2492,12 +3921,12 @@ func (s UUID) UUIDPtr() *uuid.UUID {
 }
 
 // IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
-func (s UUID) IsDefined() bool {
+func (s Float64) IsDefined() bool {
 	return s.isDefined
 }
 
 // IsNil returns true if the value is nil or undefined.
-func (s UUID) IsNil() bool {
+func (s Float64) IsNil() bool {
 	// if the value is undefined, it is nil even though "isNil" will be set to false
 	if !s.isDefined {
 		return true
@@ -2506,11 +3935,11 @@ func (s UUID) IsNil() bool {
 	return s.isNil
 }
 
-// IsZero checks if UUID is nil, which is specifically used by sqlboiler queries
-func (s UUID) IsZero() bool { return s.IsNil() }
+// IsZero checks if Float64 is nil, which is specifically used by sqlboiler queries
+func (s Float64) IsZero() bool { return s.IsNil() }
 
-// Ptr returns the pointer for UUID, but returns nil if undefined.
-func (s UUID) Ptr() *UUID {
+// Ptr returns the pointer for Float64, but returns nil if undefined.
+func (s Float64) Ptr() *Float64 {
 	if !s.isDefined {
 		return nil
 	}
@@ -2518,48 +3947,7887 @@ func (s UUID) Ptr() *UUID {
 	return &s
 }
 
-// Val returns the value of a UUID-pointer,
-// will return an undefined UUID if the pointer is nil.
-func (s *UUID) Val() UUID {
+// Val returns the value of a Float64-pointer,
+// will return an undefined Float64 if the pointer is nil.
+func (s *Float64) Val() Float64 {
 	if s == nil {
-		return NewUUIDFromPtr(nil)
+		return NewFloat64FromPtr(nil)
 	}
 
 	return *s
 }
 
+// Set sets the Float64 to the value in place, which is the same as assigning NewFloat64(v).
+func (s *Float64) Set(v float64) {
+	*s = NewFloat64(v)
+}
+
+// SetNil sets the Float64 to nil in place, which is the same as assigning NewFloat64FromPtr(nil), so it is still defined.
+func (s *Float64) SetNil() {
+	*s = NewFloat64FromPtr(nil)
+}
+
+// SetUndefined sets the Float64 to undefined in place, which is the same as assigning NewFloat64Undefined().
+func (s *Float64) SetUndefined() {
+	*s = NewFloat64Undefined()
+}
+
+// Validate validates the Float64 against the rules, see Rule.
+func (s Float64) Validate(rules ...Rule) error {
+	return validate(s, rules)
+}
+
+// Compare implements the Ordered interface, nil values sort before all non-nil values.
+func (s Float64) Compare(other any) (int, error) {
+	return compareAny(s, other, func(a, b Float64) int { return cmp.Compare(a.underlying, b.underlying) })
+}
+
+// Hash returns a hash of the state and the value, which can be used for change detection, see HashStruct.
+// Negative zero hashes the same as zero, since they are equal.
+func (s Float64) Hash() uint64 {
+	if s.underlying == 0 {
+		s.underlying = 0
+	}
+
+	return hashBinary(s)
+}
+
+// RoundingMode decides how a value is rounded to a given number of decimals.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds half away from zero, e.g. 2.5 becomes 3 and -2.5 becomes -3.
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds half to the nearest even number, e.g. 2.5 becomes 2 and 3.5 becomes 4.
+	RoundHalfEven
+	// RoundTruncate discards the decimals after the precision, e.g. 2.9 becomes 2 and -2.9 becomes -2.
+	RoundTruncate
+)
+
+// Round returns a new Float64 rounded to the given number of decimals using the given rounding mode,
+// a nil Float64 is returned as is.
+func (s Float64) Round(decimals int, mode RoundingMode) Float64 {
+	if s.IsNil() {
+		return s
+	}
+
+	pow := math.Pow10(decimals)
+	scaled := s.underlying * pow
+
+	switch mode {
+	case RoundHalfEven:
+		scaled = math.RoundToEven(scaled)
+	case RoundTruncate:
+		scaled = math.Trunc(scaled)
+	default:
+		scaled = math.Round(scaled)
+	}
+
+	return NewFloat64(scaled / pow)
+}
+
+// Add returns the sum of the Float64s, if any of them is nil a nil Float64 is returned.
+func (s Float64) Add(other Float64) Float64 {
+	if s.IsNil() || other.IsNil() {
+		return NewFloat64FromPtr(nil)
+	}
+
+	return NewFloat64(s.underlying + other.underlying)
+}
+
+// Sub returns the difference of the Float64s, if any of them is nil a nil Float64 is returned.
+func (s Float64) Sub(other Float64) Float64 {
+	if s.IsNil() || other.IsNil() {
+		return NewFloat64FromPtr(nil)
+	}
+
+	return NewFloat64(s.underlying - other.underlying)
+}
+
+// Mul returns the product of the Float64s, if any of them is nil a nil Float64 is returned.
+func (s Float64) Mul(other Float64) Float64 {
+	if s.IsNil() || other.IsNil() {
+		return NewFloat64FromPtr(nil)
+	}
+
+	return NewFloat64(s.underlying * other.underlying)
+}
+
+// Div returns the quotient of the Float64s, if any of them is nil
+// or if other is zero a nil Float64 is returned.
+func (s Float64) Div(other Float64) Float64 {
+	if s.IsNil() || other.IsNil() || other.underlying == 0 {
+		return NewFloat64FromPtr(nil)
+	}
+
+	return NewFloat64(s.underlying / other.underlying)
+}
+
+// EqualRelative returns true if the Float64s are equal within the relative tolerance,
+// i.e. the difference is at most relTol times the larger of the absolute values, e.g. 1e-9.
+// Returns true if both Float64s are nil, and false if only one of them is nil.
+func (s Float64) EqualRelative(other Float64, relTol float64) bool {
+	if s.IsNil() || other.IsNil() {
+		return s.IsNil() && other.IsNil()
+	}
+
+	if s.underlying == other.underlying {
+		return true
+	}
+
+	return math.Abs(s.underlying-other.underlying) <= relTol*math.Max(math.Abs(s.underlying), math.Abs(other.underlying))
+}
+
+// SumFloat64 returns the sum of the Float64s, nil values are skipped.
+// If there are no non-nil values a nil Float64 is returned.
+func SumFloat64(values []Float64) Float64 {
+	sum := NewFloat64FromPtr(nil)
+
+	for _, value := range values {
+		if value.IsNil() {
+			continue
+		}
+
+		if sum.IsNil() {
+			sum = value
+			continue
+		}
+
+		sum = sum.Add(value)
+	}
+
+	return sum
+}
+
+// MinFloat64 returns the smallest of the Float64s, nil values are skipped.
+// If there are no non-nil values a nil Float64 is returned.
+func MinFloat64(values []Float64) Float64 {
+	return extreme(values, func(a, b Float64) bool { return a.underlying < b.underlying })
+}
+
+// MaxFloat64 returns the largest of the Float64s, nil values are skipped.
+// If there are no non-nil values a nil Float64 is returned.
+func MaxFloat64(values []Float64) Float64 {
+	return extreme(values, func(a, b Float64) bool { return a.underlying > b.underlying })
+}
+
+// Clamp returns the Float64 bounded by low and high, where a nil bound is unbounded.
+// A nil Float64 is returned as is.
+func (s Float64) Clamp(low, high Float64) Float64 {
+	if s.IsNil() {
+		return s
+	}
+
+	if !low.IsNil() && s.underlying < low.underlying {
+		return low
+	}
+
+	if !high.IsNil() && s.underlying > high.underlying {
+		return high
+	}
+
+	return s
+}
+
+// MarshalJSON implements the json Marshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Marshaler
+func (s Float64) MarshalJSON() ([]byte, error) {
+	if s.IsNil() {
+		return nullBytes, nil
+	}
+
+	jsonBytes, err := json.Marshal(s.underlying)
+	if err != nil {
+		return nil, errors.Wrap(err, s.String())
+	}
+
+	return jsonBytes, nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Unmarshaler
+func (s *Float64) UnmarshalJSON(d []byte) error {
+	s.isNil = isNullBytes(d)
+	s.isDefined = true
+
+	if s.isNil {
+		return nil
+	}
+
+	err := json.Unmarshal(d, &s.underlying)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalYAML implements the yaml Marshaler interface.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Marshaler
+func (s Float64) MarshalYAML() (interface{}, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+
+	return s.underlying, nil
+}
+
+// UnmarshalYAML implements the yaml Unmarshaler interface.
+//
+// Note that yaml.v3 does not call UnmarshalYAML for null values,
+// so a null in the YAML input leaves the value undefined.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Unmarshaler
+func (s *Float64) UnmarshalYAML(value *yaml.Node) error {
+	s.isNil = false
+	s.isDefined = true
+
+	return value.Decode(&s.underlying)
+}
+
+// MarshalBinary implements the encoding BinaryMarshaler interface,
+// the value is encoded as a state byte (undefined, nil or defined) followed by the value.
+//
+// See: https://pkg.go.dev/encoding#BinaryMarshaler
+func (s Float64) MarshalBinary() ([]byte, error) {
+	if s.IsNil() {
+		return marshalBinaryNil(s.isDefined), nil
+	}
+
+	return append([]byte{binaryDefined}, binary.BigEndian.AppendUint64(nil, math.Float64bits(s.underlying))...), nil
+}
+
+// UnmarshalBinary implements the encoding BinaryUnmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (s *Float64) UnmarshalBinary(d []byte) error {
+	isDefined, isNil, payload, err := unmarshalBinaryState(d)
+	if err != nil {
+		return err
+	}
+
+	var underlying float64
+	if isDefined && !isNil {
+		err := checkBinaryLength(payload, 8)
+		if err != nil {
+			return err
+		}
+
+		underlying = math.Float64frombits(binary.BigEndian.Uint64(payload))
+	}
+
+	*s = Float64{
+		underlying: underlying,
+		isDefined:  isDefined,
+		isNil:      isNil,
+	}
+
+	return nil
+}
+
+// EncodeMsgpack implements the msgpack CustomEncoder interface,
+// nil and undefined values are encoded as an extension type to keep the state.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomEncoder
+func (s Float64) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if s.IsNil() {
+		return encodeMsgpackNil(enc, s.isDefined)
+	}
+
+	return enc.EncodeFloat64(s.underlying)
+}
+
+// DecodeMsgpack implements the msgpack CustomDecoder interface.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomDecoder
+func (s *Float64) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isDefined, isNil, err := decodeMsgpackState(dec)
+	if err != nil {
+		return err
+	}
+
+	if !isDefined || isNil {
+		*s = Float64{
+			isDefined: isDefined,
+			isNil:     isNil,
+		}
+
+		return nil
+	}
+
+	underlying, err := dec.DecodeFloat64()
+	if err != nil {
+		return err
+	}
+
+	*s = Float64{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}
+
+	return nil
+}
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (s *Float64) Scan(value interface{}) error {
+	s.isNil = (nil == value)
+	s.isDefined = true
+
+	if s.isNil {
+		s.underlying = 0
+		return nil
+	}
+
+	// Some drivers return numeric columns as text, which always has a dot as the decimal separator
+	// regardless of SetFloat64DecimalSeparator
+	if v, ok := value.([]byte); ok {
+		value = string(v)
+	}
+
+	if str, ok := value.(string); ok {
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(str), 64)
+		if err == nil {
+			s.underlying = parsed
+			return nil
+		}
+	}
+
+	return convert.ConvertAssign(&s.underlying, value)
+}
+
+// Value implements the driver Valuer interface.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (s Float64) Value() (driver.Value, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+	return s.underlying, nil
+}
+
+// Int is used to represent integers.
+type Int struct {
+	underlying int
+	isDefined  bool
+	isNil      bool
+}
+
+// NewInt creates a new Int object.
+func NewInt(underlying int) Int {
+	return Int{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}
+}
+
+// NewIntFromPtr creates a new Int object from a pointer.
+func NewIntFromPtr(underlying *int) Int {
+	if underlying != nil {
+		return NewInt(*underlying)
+	}
+
+	return Int{
+		isDefined: true,
+		isNil:     true,
+	}
+}
+
+// NewIntUndefined creates a new undefined Int object.
+func NewIntUndefined() Int {
+	return Int{}
+}
+
+// IntOrDefault returns the underlying value of Int,
+// or the given default if the Int is nil or undefined.
+func IntOrDefault(s Int, def int) int {
+	if s.IsNil() {
+		return def
+	}
+
+	return s.underlying
+}
+
+func IntFromStringPtr(strPtr *string) (Int, error) {
+	if strPtr == nil {
+		return NewIntFromPtr(nil), nil
+	}
+
+	return IntFromString(*strPtr)
+}
+
+func IntFromString(str string) (Int, error) {
+	if str == "" {
+		return NewIntFromPtr(nil), nil
+	}
+
+	parsed, err := strconv.ParseInt(strings.TrimSpace(str), 10, 64)
+	underlying := int(parsed)
+
+	if err != nil {
+		return Int{}, err
+	}
+
+	return Int{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}, nil
+}
+
+// MustIntFromString is like IntFromString but panics if the string cannot be parsed, like uuid.MustParse.
+// It is meant for tests and package-level variables, e.g. MustIntFromString("42"), never for untrusted input.
+func MustIntFromString(str string) Int {
+	return must(IntFromString(str))
+}
+
+// IntsFromStrings parses the strings with IntFromString,
+// an error is returned for the first string that cannot be parsed.
+func IntsFromStrings(strs []string) ([]Int, error) {
+	return fromStrings(strs, IntFromString)
+}
+
+// IntsToStrings converts the values to strings with String, a nil value becomes an empty string.
+func IntsToStrings(values []Int) []string {
+	return toStrings(values)
+}
+
+// IntFromStringClamped parses the string like IntFromString, but handles values outside of the
+// 32-bit INTEGER range, which is what Int represents in the database.
+//
+// If clamp is true, out of range values are clamped to the min or max value,
+// otherwise an error is returned.
+func IntFromStringClamped(str string, clamp bool) (Int, error) {
+	if str == "" {
+		return NewIntFromPtr(nil), nil
+	}
+
+	parsed, err := parseIntClamped(str, math.MinInt32, math.MaxInt32, clamp)
+	if err != nil {
+		return Int{}, err
+	}
+
+	return NewInt(int(parsed)), nil
+}
+
+// String output Int
+func (s Int) String() string {
+	// If the value is nil we return an empty string
+	if s.IsNil() {
+		return ""
+	}
+
+	return fmt.Sprintf("%d", s.underlying)
+}
+
+// CSVValue returns the value for a CSV field, which is the same as String and an empty string for nil.
+func (s Int) CSVValue() string {
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.String()
+}
+
+// Int returns the int value.
+func (s Int) Int() int {
+	return s.underlying
+}
+
+// IntPtr returns the int value as a pointer.
+func (s Int) IntPtr() *int {
+	if s.IsNil() {
+		return nil
+	}
+	return &s.underlying
+}
+
+// IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
+func (s Int) IsDefined() bool {
+	return s.isDefined
+}
+
+// IsNil returns true if the value is nil or undefined.
+func (s Int) IsNil() bool {
+	// if the value is undefined, it is nil even though "isNil" will be set to false
+	if !s.isDefined {
+		return true
+	}
+
+	return s.isNil
+}
+
+// IsZero checks if Int is nil, which is specifically used by sqlboiler queries
+func (s Int) IsZero() bool { return s.IsNil() }
+
+// Ptr returns the pointer for Int, but returns nil if undefined.
+func (s Int) Ptr() *Int {
+	if !s.isDefined {
+		return nil
+	}
+
+	return &s
+}
+
+// Val returns the value of a Int-pointer,
+// will return an undefined Int if the pointer is nil.
+func (s *Int) Val() Int {
+	if s == nil {
+		return NewIntFromPtr(nil)
+	}
+
+	return *s
+}
+
+// Set sets the Int to the value in place, which is the same as assigning NewInt(v).
+func (s *Int) Set(v int) {
+	*s = NewInt(v)
+}
+
+// SetNil sets the Int to nil in place, which is the same as assigning NewIntFromPtr(nil), so it is still defined.
+func (s *Int) SetNil() {
+	*s = NewIntFromPtr(nil)
+}
+
+// SetUndefined sets the Int to undefined in place, which is the same as assigning NewIntUndefined().
+func (s *Int) SetUndefined() {
+	*s = NewIntUndefined()
+}
+
+// Validate validates the Int against the rules, see Rule.
+func (s Int) Validate(rules ...Rule) error {
+	return validate(s, rules)
+}
+
+// Compare implements the Ordered interface, nil values sort before all non-nil values.
+func (s Int) Compare(other any) (int, error) {
+	return compareAny(s, other, func(a, b Int) int { return cmp.Compare(a.underlying, b.underlying) })
+}
+
+// Hash returns a hash of the state and the value, which can be used for change detection, see HashStruct.
+func (s Int) Hash() uint64 {
+	return hashBinary(s)
+}
+
+// Add returns the sum of the Ints, if any of them is nil a nil Int is returned.
+func (s Int) Add(other Int) Int {
+	if s.IsNil() || other.IsNil() {
+		return NewIntFromPtr(nil)
+	}
+
+	return NewInt(s.underlying + other.underlying)
+}
+
+// Sub returns the difference of the Ints, if any of them is nil a nil Int is returned.
+func (s Int) Sub(other Int) Int {
+	if s.IsNil() || other.IsNil() {
+		return NewIntFromPtr(nil)
+	}
+
+	return NewInt(s.underlying - other.underlying)
+}
+
+// Mul returns the product of the Ints, if any of them is nil a nil Int is returned.
+func (s Int) Mul(other Int) Int {
+	if s.IsNil() || other.IsNil() {
+		return NewIntFromPtr(nil)
+	}
+
+	return NewInt(s.underlying * other.underlying)
+}
+
+// Div returns the quotient of the Ints truncated towards zero, if any of them is nil
+// or if other is zero a nil Int is returned.
+func (s Int) Div(other Int) Int {
+	if s.IsNil() || other.IsNil() || other.underlying == 0 {
+		return NewIntFromPtr(nil)
+	}
+
+	return NewInt(s.underlying / other.underlying)
+}
+
+// SumInt returns the sum of the Ints, nil values are skipped.
+// If there are no non-nil values a nil Int is returned.
+func SumInt(values []Int) Int {
+	sum := NewIntFromPtr(nil)
+
+	for _, value := range values {
+		if value.IsNil() {
+			continue
+		}
+
+		if sum.IsNil() {
+			sum = value
+			continue
+		}
+
+		sum = sum.Add(value)
+	}
+
+	return sum
+}
+
+// MinInt returns the smallest of the Ints, nil values are skipped.
+// If there are no non-nil values a nil Int is returned.
+func MinInt(values []Int) Int {
+	return extreme(values, func(a, b Int) bool { return a.underlying < b.underlying })
+}
+
+// MaxInt returns the largest of the Ints, nil values are skipped.
+// If there are no non-nil values a nil Int is returned.
+func MaxInt(values []Int) Int {
+	return extreme(values, func(a, b Int) bool { return a.underlying > b.underlying })
+}
+
+// Clamp returns the Int bounded by low and high, where a nil bound is unbounded.
+// A nil Int is returned as is.
+func (s Int) Clamp(low, high Int) Int {
+	if s.IsNil() {
+		return s
+	}
+
+	if !low.IsNil() && s.underlying < low.underlying {
+		return low
+	}
+
+	if !high.IsNil() && s.underlying > high.underlying {
+		return high
+	}
+
+	return s
+}
+
+// MarshalJSON implements the json Marshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Marshaler
+func (s Int) MarshalJSON() ([]byte, error) {
+	if s.IsNil() {
+		return nullBytes, nil
+	}
+
+	jsonBytes, err := json.Marshal(s.underlying)
+	if err != nil {
+		return nil, errors.Wrap(err, s.String())
+	}
+
+	return jsonBytes, nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Unmarshaler
+func (s *Int) UnmarshalJSON(d []byte) error {
+	s.isNil = isNullBytes(d)
+	s.isDefined = true
+
+	if s.isNil {
+		return nil
+	}
+
+	err := json.Unmarshal(d, &s.underlying)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalYAML implements the yaml Marshaler interface.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Marshaler
+func (s Int) MarshalYAML() (interface{}, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+
+	return s.underlying, nil
+}
+
+// UnmarshalYAML implements the yaml Unmarshaler interface.
+//
+// Note that yaml.v3 does not call UnmarshalYAML for null values,
+// so a null in the YAML input leaves the value undefined.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Unmarshaler
+func (s *Int) UnmarshalYAML(value *yaml.Node) error {
+	s.isNil = false
+	s.isDefined = true
+
+	return value.Decode(&s.underlying)
+}
+
+// MarshalBinary implements the encoding BinaryMarshaler interface,
+// the value is encoded as a state byte (undefined, nil or defined) followed by the value.
+//
+// See: https://pkg.go.dev/encoding#BinaryMarshaler
+func (s Int) MarshalBinary() ([]byte, error) {
+	if s.IsNil() {
+		return marshalBinaryNil(s.isDefined), nil
+	}
+
+	return append([]byte{binaryDefined}, binary.BigEndian.AppendUint64(nil, uint64(s.underlying))...), nil
+}
+
+// UnmarshalBinary implements the encoding BinaryUnmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (s *Int) UnmarshalBinary(d []byte) error {
+	isDefined, isNil, payload, err := unmarshalBinaryState(d)
+	if err != nil {
+		return err
+	}
+
+	var underlying int
+	if isDefined && !isNil {
+		err := checkBinaryLength(payload, 8)
+		if err != nil {
+			return err
+		}
+
+		underlying = int(binary.BigEndian.Uint64(payload))
+	}
+
+	*s = Int{
+		underlying: underlying,
+		isDefined:  isDefined,
+		isNil:      isNil,
+	}
+
+	return nil
+}
+
+// EncodeMsgpack implements the msgpack CustomEncoder interface,
+// nil and undefined values are encoded as an extension type to keep the state.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomEncoder
+func (s Int) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if s.IsNil() {
+		return encodeMsgpackNil(enc, s.isDefined)
+	}
+
+	return enc.EncodeInt(int64(s.underlying))
+}
+
+// DecodeMsgpack implements the msgpack CustomDecoder interface.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomDecoder
+func (s *Int) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isDefined, isNil, err := decodeMsgpackState(dec)
+	if err != nil {
+		return err
+	}
+
+	if !isDefined || isNil {
+		*s = Int{
+			isDefined: isDefined,
+			isNil:     isNil,
+		}
+
+		return nil
+	}
+
+	underlying, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+
+	*s = Int{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}
+
+	return nil
+}
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (s *Int) Scan(value interface{}) error {
+	s.isNil = (nil == value)
+	s.isDefined = true
+
+	if s.isNil {
+		s.underlying = 0
+		return nil
+	}
+
+	// Some drivers return numeric columns and e.g. COUNT(*) as text
+	if v, ok := value.([]byte); ok {
+		value = string(v)
+	}
+
+	if str, ok := value.(string); ok {
+		parsed, err := IntFromString(str)
+		if err == nil && !parsed.IsNil() {
+			s.underlying = parsed.underlying
+			return nil
+		}
+	}
+
+	return convert.ConvertAssign(&s.underlying, value)
+}
+
+// Value implements the driver Valuer interface.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (s Int) Value() (driver.Value, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+	return int64(s.underlying), nil
+}
+
+// Int16 is used to represent 16-bit integers.
+type Int16 struct {
+	underlying int16
+	isDefined  bool
+	isNil      bool
+}
+
+// NewInt16 creates a new Int16 object.
+func NewInt16(underlying int16) Int16 {
+	return Int16{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}
+}
+
+// NewInt16FromPtr creates a new Int16 object from a pointer.
+func NewInt16FromPtr(underlying *int16) Int16 {
+	if underlying != nil {
+		return NewInt16(*underlying)
+	}
+
+	return Int16{
+		isDefined: true,
+		isNil:     true,
+	}
+}
+
+// NewInt16Undefined creates a new undefined Int16 object.
+func NewInt16Undefined() Int16 {
+	return Int16{}
+}
+
+// Int16OrDefault returns the underlying value of Int16,
+// or the given default if the Int16 is nil or undefined.
+func Int16OrDefault(s Int16, def int16) int16 {
+	if s.IsNil() {
+		return def
+	}
+
+	return s.underlying
+}
+
+func Int16FromStringPtr(strPtr *string) (Int16, error) {
+	if strPtr == nil {
+		return NewInt16FromPtr(nil), nil
+	}
+
+	return Int16FromString(*strPtr)
+}
+
+// Int16FromString parses the string as an Int16, an error is returned for values outside of the int16 range.
+func Int16FromString(str string) (Int16, error) {
+	return Int16FromStringClamped(str, false)
+}
+
+// MustInt16FromString is like Int16FromString but panics if the string cannot be parsed, like uuid.MustParse.
+// It is meant for tests and package-level variables, e.g. MustInt16FromString("42"), never for untrusted input.
+func MustInt16FromString(str string) Int16 {
+	return must(Int16FromString(str))
+}
+
+// Int16sFromStrings parses the strings with Int16FromString,
+// an error is returned for the first string that cannot be parsed.
+func Int16sFromStrings(strs []string) ([]Int16, error) {
+	return fromStrings(strs, Int16FromString)
+}
+
+// Int16sToStrings converts the values to strings with String, a nil value becomes an empty string.
+func Int16sToStrings(values []Int16) []string {
+	return toStrings(values)
+}
+
+// Int16FromStringClamped parses the string like Int16FromString, but can clamp values outside of the int16 range.
+//
+// If clamp is true, out of range values are clamped to the min or max value,
+// otherwise an error is returned.
+func Int16FromStringClamped(str string, clamp bool) (Int16, error) {
+	if str == "" {
+		return NewInt16FromPtr(nil), nil
+	}
+
+	parsed, err := parseIntClamped(str, math.MinInt16, math.MaxInt16, clamp)
+	if err != nil {
+		return Int16{}, err
+	}
+
+	return NewInt16(int16(parsed)), nil
+}
+
+// String output Int16
+func (s Int16) String() string {
+	// If the value is nil we return an empty string
+	if s.IsNil() {
+		return ""
+	}
+
+	return fmt.Sprintf("%d", s.underlying)
+}
+
+// CSVValue returns the value for a CSV field, which is the same as String and an empty string for nil.
+func (s Int16) CSVValue() string {
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.String()
+}
+
+// Int16 returns the int16 value.
+func (s Int16) Int16() int16 {
+	return s.underlying
+}
+
+// Int16Ptr returns the int16 value as a pointer.
+func (s Int16) Int16Ptr() *int16 {
+	if s.IsNil() {
+		return nil
+	}
+	return &s.underlying
+}
+
+// IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
+func (s Int16) IsDefined() bool {
+	return s.isDefined
+}
+
+// IsNil returns true if the value is nil or undefined.
+func (s Int16) IsNil() bool {
+	// if the value is undefined, it is nil even though "isNil" will be set to false
+	if !s.isDefined {
+		return true
+	}
+
+	return s.isNil
+}
+
+// IsZero checks if Int16 is nil, which is specifically used by sqlboiler queries
+func (s Int16) IsZero() bool { return s.IsNil() }
+
+// Ptr returns the pointer for Int16, but returns nil if undefined.
+func (s Int16) Ptr() *Int16 {
+	if !s.isDefined {
+		return nil
+	}
+
+	return &s
+}
+
+// Val returns the value of a Int16-pointer,
+// will return an undefined Int16 if the pointer is nil.
+func (s *Int16) Val() Int16 {
+	if s == nil {
+		return NewInt16FromPtr(nil)
+	}
+
+	return *s
+}
+
+// Set sets the Int16 to the value in place, which is the same as assigning NewInt16(v).
+func (s *Int16) Set(v int16) {
+	*s = NewInt16(v)
+}
+
+// SetNil sets the Int16 to nil in place, which is the same as assigning NewInt16FromPtr(nil), so it is still defined.
+func (s *Int16) SetNil() {
+	*s = NewInt16FromPtr(nil)
+}
+
+// SetUndefined sets the Int16 to undefined in place, which is the same as assigning NewInt16Undefined().
+func (s *Int16) SetUndefined() {
+	*s = NewInt16Undefined()
+}
+
+// Validate validates the Int16 against the rules, see Rule.
+func (s Int16) Validate(rules ...Rule) error {
+	return validate(s, rules)
+}
+
+// Compare implements the Ordered interface, nil values sort before all non-nil values.
+func (s Int16) Compare(other any) (int, error) {
+	return compareAny(s, other, func(a, b Int16) int { return cmp.Compare(a.underlying, b.underlying) })
+}
+
+// Hash returns a hash of the state and the value, which can be used for change detection, see HashStruct.
+func (s Int16) Hash() uint64 {
+	return hashBinary(s)
+}
+
+// MinInt16 returns the smallest of the Int16s, nil values are skipped.
+// If there are no non-nil values a nil Int16 is returned.
+func MinInt16(values []Int16) Int16 {
+	return extreme(values, func(a, b Int16) bool { return a.underlying < b.underlying })
+}
+
+// MaxInt16 returns the largest of the Int16s, nil values are skipped.
+// If there are no non-nil values a nil Int16 is returned.
+func MaxInt16(values []Int16) Int16 {
+	return extreme(values, func(a, b Int16) bool { return a.underlying > b.underlying })
+}
+
+// Clamp returns the Int16 bounded by low and high, where a nil bound is unbounded.
+// A nil Int16 is returned as is.
+func (s Int16) Clamp(low, high Int16) Int16 {
+	if s.IsNil() {
+		return s
+	}
+
+	if !low.IsNil() && s.underlying < low.underlying {
+		return low
+	}
+
+	if !high.IsNil() && s.underlying > high.underlying {
+		return high
+	}
+
+	return s
+}
+
+// MarshalJSON implements the json Marshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Marshaler
+func (s Int16) MarshalJSON() ([]byte, error) {
+	if s.IsNil() {
+		return nullBytes, nil
+	}
+
+	jsonBytes, err := json.Marshal(s.underlying)
+	if err != nil {
+		return nil, errors.Wrap(err, s.String())
+	}
+
+	return jsonBytes, nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Unmarshaler
+func (s *Int16) UnmarshalJSON(d []byte) error {
+	s.isNil = isNullBytes(d)
+	s.isDefined = true
+
+	if s.isNil {
+		return nil
+	}
+
+	err := json.Unmarshal(d, &s.underlying)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalYAML implements the yaml Marshaler interface.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Marshaler
+func (s Int16) MarshalYAML() (interface{}, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+
+	return s.underlying, nil
+}
+
+// UnmarshalYAML implements the yaml Unmarshaler interface.
+//
+// Note that yaml.v3 does not call UnmarshalYAML for null values,
+// so a null in the YAML input leaves the value undefined.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Unmarshaler
+func (s *Int16) UnmarshalYAML(value *yaml.Node) error {
+	s.isNil = false
+	s.isDefined = true
+
+	return value.Decode(&s.underlying)
+}
+
+// MarshalBinary implements the encoding BinaryMarshaler interface,
+// the value is encoded as a state byte (undefined, nil or defined) followed by the value.
+//
+// See: https://pkg.go.dev/encoding#BinaryMarshaler
+func (s Int16) MarshalBinary() ([]byte, error) {
+	if s.IsNil() {
+		return marshalBinaryNil(s.isDefined), nil
+	}
+
+	return append([]byte{binaryDefined}, binary.BigEndian.AppendUint16(nil, uint16(s.underlying))...), nil
+}
+
+// UnmarshalBinary implements the encoding BinaryUnmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (s *Int16) UnmarshalBinary(d []byte) error {
+	isDefined, isNil, payload, err := unmarshalBinaryState(d)
+	if err != nil {
+		return err
+	}
+
+	var underlying int16
+	if isDefined && !isNil {
+		err := checkBinaryLength(payload, 2)
+		if err != nil {
+			return err
+		}
+
+		underlying = int16(binary.BigEndian.Uint16(payload))
+	}
+
+	*s = Int16{
+		underlying: underlying,
+		isDefined:  isDefined,
+		isNil:      isNil,
+	}
+
+	return nil
+}
+
+// EncodeMsgpack implements the msgpack CustomEncoder interface,
+// nil and undefined values are encoded as an extension type to keep the state.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomEncoder
+func (s Int16) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if s.IsNil() {
+		return encodeMsgpackNil(enc, s.isDefined)
+	}
+
+	return enc.EncodeInt(int64(s.underlying))
+}
+
+// DecodeMsgpack implements the msgpack CustomDecoder interface.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomDecoder
+func (s *Int16) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isDefined, isNil, err := decodeMsgpackState(dec)
+	if err != nil {
+		return err
+	}
+
+	if !isDefined || isNil {
+		*s = Int16{
+			isDefined: isDefined,
+			isNil:     isNil,
+		}
+
+		return nil
+	}
+
+	underlying, err := dec.DecodeInt16()
+	if err != nil {
+		return err
+	}
+
+	*s = Int16{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}
+
+	return nil
+}
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (s *Int16) Scan(value interface{}) error {
+	s.isNil = (nil == value)
+	s.isDefined = true
+
+	if s.isNil {
+		s.underlying = 0
+		return nil
+	}
+
+	// Some drivers return numeric columns and e.g. COUNT(*) as text
+	if v, ok := value.([]byte); ok {
+		value = string(v)
+	}
+
+	if str, ok := value.(string); ok {
+		parsed, err := Int16FromStringClamped(str, false)
+		if err == nil && !parsed.IsNil() {
+			s.underlying = parsed.underlying
+			return nil
+		}
+	}
+
+	return convert.ConvertAssign(&s.underlying, value)
+}
+
+// Value implements the driver Valuer interface.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (s Int16) Value() (driver.Value, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+	return int64(s.underlying), nil
+}
+
+// Int64 is used to represent 64-bit integers.
+type Int64 struct {
+	underlying int64
+	isDefined  bool
+	isNil      bool
+}
+
+// NewInt64 creates a new Int64 object.
+func NewInt64(underlying int64) Int64 {
+	return Int64{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}
+}
+
+// NewInt64FromPtr creates a new Int64 object from a pointer.
+func NewInt64FromPtr(underlying *int64) Int64 {
+	if underlying != nil {
+		return NewInt64(*underlying)
+	}
+
+	return Int64{
+		isDefined: true,
+		isNil:     true,
+	}
+}
+
+// NewInt64Undefined creates a new undefined Int64 object.
+func NewInt64Undefined() Int64 {
+	return Int64{}
+}
+
+// Int64OrDefault returns the underlying value of Int64,
+// or the given default if the Int64 is nil or undefined.
+func Int64OrDefault(s Int64, def int64) int64 {
+	if s.IsNil() {
+		return def
+	}
+
+	return s.underlying
+}
+
+func Int64FromStringPtr(strPtr *string) (Int64, error) {
+	if strPtr == nil {
+		return NewInt64FromPtr(nil), nil
+	}
+
+	return Int64FromString(*strPtr)
+}
+
+func Int64FromString(str string) (Int64, error) {
+	if str == "" {
+		return NewInt64FromPtr(nil), nil
+	}
+
+	parsed, err := strconv.ParseInt(strings.TrimSpace(str), 10, 64)
+	underlying := int64(parsed)
+
+	if err != nil {
+		return Int64{}, err
+	}
+
+	return Int64{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}, nil
+}
+
+// MustInt64FromString is like Int64FromString but panics if the string cannot be parsed, like uuid.MustParse.
+// It is meant for tests and package-level variables, e.g. MustInt64FromString("42"), never for untrusted input.
+func MustInt64FromString(str string) Int64 {
+	return must(Int64FromString(str))
+}
+
+// Int64sFromStrings parses the strings with Int64FromString,
+// an error is returned for the first string that cannot be parsed.
+func Int64sFromStrings(strs []string) ([]Int64, error) {
+	return fromStrings(strs, Int64FromString)
+}
+
+// Int64sToStrings converts the values to strings with String, a nil value becomes an empty string.
+func Int64sToStrings(values []Int64) []string {
+	return toStrings(values)
+}
+
+// String output Int64
+func (s Int64) String() string {
+	// If the value is nil we return an empty string
+	if s.IsNil() {
+		return ""
+	}
+
+	return fmt.Sprintf("%d", s.underlying)
+}
+
+// CSVValue returns the value for a CSV field, which is the same as String and an empty string for nil.
+func (s Int64) CSVValue() string {
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.String()
+}
+
+// Int64 returns the int64 value.
+func (s Int64) Int64() int64 {
+	return s.underlying
+}
+
+// Int64Ptr returns the int64 value as a pointer.
+func (s Int64) Int64Ptr() *int64 {
+	if s.IsNil() {
+		return nil
+	}
+	return &s.underlying
+}
+
+// IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
+func (s Int64) IsDefined() bool {
+	return s.isDefined
+}
+
+// IsNil returns true if the value is nil or undefined.
+func (s Int64) IsNil() bool {
+	// if the value is undefined, it is nil even though "isNil" will be set to false
+	if !s.isDefined {
+		return true
+	}
+
+	return s.isNil
+}
+
+// IsZero checks if Int64 is nil, which is specifically used by sqlboiler queries
+func (s Int64) IsZero() bool { return s.IsNil() }
+
+// Ptr returns the pointer for Int64, but returns nil if undefined.
+func (s Int64) Ptr() *Int64 {
+	if !s.isDefined {
+		return nil
+	}
+
+	return &s
+}
+
+// Val returns the value of a Int64-pointer,
+// will return an undefined Int64 if the pointer is nil.
+func (s *Int64) Val() Int64 {
+	if s == nil {
+		return NewInt64FromPtr(nil)
+	}
+
+	return *s
+}
+
+// Set sets the Int64 to the value in place, which is the same as assigning NewInt64(v).
+func (s *Int64) Set(v int64) {
+	*s = NewInt64(v)
+}
+
+// SetNil sets the Int64 to nil in place, which is the same as assigning NewInt64FromPtr(nil), so it is still defined.
+func (s *Int64) SetNil() {
+	*s = NewInt64FromPtr(nil)
+}
+
+// SetUndefined sets the Int64 to undefined in place, which is the same as assigning NewInt64Undefined().
+func (s *Int64) SetUndefined() {
+	*s = NewInt64Undefined()
+}
+
+// Validate validates the Int64 against the rules, see Rule.
+func (s Int64) Validate(rules ...Rule) error {
+	return validate(s, rules)
+}
+
+// Compare implements the Ordered interface, nil values sort before all non-nil values.
+func (s Int64) Compare(other any) (int, error) {
+	return compareAny(s, other, func(a, b Int64) int { return cmp.Compare(a.underlying, b.underlying) })
+}
+
+// Hash returns a hash of the state and the value, which can be used for change detection, see HashStruct.
+func (s Int64) Hash() uint64 {
+	return hashBinary(s)
+}
+
+// Add returns the sum of the Int64s, if any of them is nil a nil Int64 is returned.
+func (s Int64) Add(other Int64) Int64 {
+	if s.IsNil() || other.IsNil() {
+		return NewInt64FromPtr(nil)
+	}
+
+	return NewInt64(s.underlying + other.underlying)
+}
+
+// Sub returns the difference of the Int64s, if any of them is nil a nil Int64 is returned.
+func (s Int64) Sub(other Int64) Int64 {
+	if s.IsNil() || other.IsNil() {
+		return NewInt64FromPtr(nil)
+	}
+
+	return NewInt64(s.underlying - other.underlying)
+}
+
+// Mul returns the product of the Int64s, if any of them is nil a nil Int64 is returned.
+func (s Int64) Mul(other Int64) Int64 {
+	if s.IsNil() || other.IsNil() {
+		return NewInt64FromPtr(nil)
+	}
+
+	return NewInt64(s.underlying * other.underlying)
+}
+
+// Div returns the quotient of the Int64s truncated towards zero, if any of them is nil
+// or if other is zero a nil Int64 is returned.
+func (s Int64) Div(other Int64) Int64 {
+	if s.IsNil() || other.IsNil() || other.underlying == 0 {
+		return NewInt64FromPtr(nil)
+	}
+
+	return NewInt64(s.underlying / other.underlying)
+}
+
+// SumInt64 returns the sum of the Int64s, nil values are skipped.
+// If there are no non-nil values a nil Int64 is returned.
+func SumInt64(values []Int64) Int64 {
+	sum := NewInt64FromPtr(nil)
+
+	for _, value := range values {
+		if value.IsNil() {
+			continue
+		}
+
+		if sum.IsNil() {
+			sum = value
+			continue
+		}
+
+		sum = sum.Add(value)
+	}
+
+	return sum
+}
+
+// MinInt64 returns the smallest of the Int64s, nil values are skipped.
+// If there are no non-nil values a nil Int64 is returned.
+func MinInt64(values []Int64) Int64 {
+	return extreme(values, func(a, b Int64) bool { return a.underlying < b.underlying })
+}
+
+// MaxInt64 returns the largest of the Int64s, nil values are skipped.
+// If there are no non-nil values a nil Int64 is returned.
+func MaxInt64(values []Int64) Int64 {
+	return extreme(values, func(a, b Int64) bool { return a.underlying > b.underlying })
+}
+
+// Clamp returns the Int64 bounded by low and high, where a nil bound is unbounded.
+// A nil Int64 is returned as is.
+func (s Int64) Clamp(low, high Int64) Int64 {
+	if s.IsNil() {
+		return s
+	}
+
+	if !low.IsNil() && s.underlying < low.underlying {
+		return low
+	}
+
+	if !high.IsNil() && s.underlying > high.underlying {
+		return high
+	}
+
+	return s
+}
+
+// MarshalJSON implements the json Marshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Marshaler
+func (s Int64) MarshalJSON() ([]byte, error) {
+	if s.IsNil() {
+		return nullBytes, nil
+	}
+
+	jsonBytes, err := json.Marshal(s.underlying)
+	if err != nil {
+		return nil, errors.Wrap(err, s.String())
+	}
+
+	return jsonBytes, nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Unmarshaler
+func (s *Int64) UnmarshalJSON(d []byte) error {
+	s.isNil = isNullBytes(d)
+	s.isDefined = true
+
+	if s.isNil {
+		return nil
+	}
+
+	err := json.Unmarshal(d, &s.underlying)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalYAML implements the yaml Marshaler interface.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Marshaler
+func (s Int64) MarshalYAML() (interface{}, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+
+	return s.underlying, nil
+}
+
+// UnmarshalYAML implements the yaml Unmarshaler interface.
+//
+// Note that yaml.v3 does not call UnmarshalYAML for null values,
+// so a null in the YAML input leaves the value undefined.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Unmarshaler
+func (s *Int64) UnmarshalYAML(value *yaml.Node) error {
+	s.isNil = false
+	s.isDefined = true
+
+	return value.Decode(&s.underlying)
+}
+
+// MarshalBinary implements the encoding BinaryMarshaler interface,
+// the value is encoded as a state byte (undefined, nil or defined) followed by the value.
+//
+// See: https://pkg.go.dev/encoding#BinaryMarshaler
+func (s Int64) MarshalBinary() ([]byte, error) {
+	if s.IsNil() {
+		return marshalBinaryNil(s.isDefined), nil
+	}
+
+	return append([]byte{binaryDefined}, binary.BigEndian.AppendUint64(nil, uint64(s.underlying))...), nil
+}
+
+// UnmarshalBinary implements the encoding BinaryUnmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (s *Int64) UnmarshalBinary(d []byte) error {
+	isDefined, isNil, payload, err := unmarshalBinaryState(d)
+	if err != nil {
+		return err
+	}
+
+	var underlying int64
+	if isDefined && !isNil {
+		err := checkBinaryLength(payload, 8)
+		if err != nil {
+			return err
+		}
+
+		underlying = int64(binary.BigEndian.Uint64(payload))
+	}
+
+	*s = Int64{
+		underlying: underlying,
+		isDefined:  isDefined,
+		isNil:      isNil,
+	}
+
+	return nil
+}
+
+// EncodeMsgpack implements the msgpack CustomEncoder interface,
+// nil and undefined values are encoded as an extension type to keep the state.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomEncoder
+func (s Int64) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if s.IsNil() {
+		return encodeMsgpackNil(enc, s.isDefined)
+	}
+
+	return enc.EncodeInt(s.underlying)
+}
+
+// DecodeMsgpack implements the msgpack CustomDecoder interface.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomDecoder
+func (s *Int64) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isDefined, isNil, err := decodeMsgpackState(dec)
+	if err != nil {
+		return err
+	}
+
+	if !isDefined || isNil {
+		*s = Int64{
+			isDefined: isDefined,
+			isNil:     isNil,
+		}
+
+		return nil
+	}
+
+	underlying, err := dec.DecodeInt64()
+	if err != nil {
+		return err
+	}
+
+	*s = Int64{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}
+
+	return nil
+}
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (s *Int64) Scan(value interface{}) error {
+	s.isNil = (nil == value)
+	s.isDefined = true
+
+	if s.isNil {
+		s.underlying = 0
+		return nil
+	}
+
+	// Some drivers return numeric columns and e.g. COUNT(*) as text
+	if v, ok := value.([]byte); ok {
+		value = string(v)
+	}
+
+	if str, ok := value.(string); ok {
+		parsed, err := Int64FromString(str)
+		if err == nil && !parsed.IsNil() {
+			s.underlying = parsed.underlying
+			return nil
+		}
+	}
+
+	return convert.ConvertAssign(&s.underlying, value)
+}
+
+// Value implements the driver Valuer interface.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (s Int64) Value() (driver.Value, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+	return int64(s.underlying), nil
+}
+
+// JSON is used to represent JSON data.
+type JSON struct {
+	underlying json.RawMessage
+	isDefined  bool
+	isNil      bool
+}
+
+// NewJSON creates a new JSON object.
+func NewJSON(underlying json.RawMessage) JSON {
+	return JSON{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}
+}
+
+// NewJSONFromPtr creates a new JSON object from a pointer.
+func NewJSONFromPtr(underlying *json.RawMessage) JSON {
+	if underlying != nil {
+		return NewJSON(*underlying)
+	}
+
+	return JSON{
+		isDefined: true,
+		isNil:     true,
+	}
+}
+
+// NewJSONUndefined creates a new undefined JSON object.
+func NewJSONUndefined() JSON {
+	return JSON{}
+}
+
+func JSONFromStringPtr(strPtr *string) (JSON, error) {
+	if strPtr == nil {
+		return NewJSONFromPtr(nil), nil
+	}
+
+	return JSONFromString(*strPtr)
+}
+
+// JSONFromString creates a new JSON object from a string containing raw JSON,
+// an error is returned if the string isn't valid JSON.
+func JSONFromString(str string) (JSON, error) {
+	if str == "" {
+		return NewJSONFromPtr(nil), nil
+	}
+
+	underlying := json.RawMessage(strings.TrimSpace(str))
+	if !json.Valid(underlying) {
+		return JSON{}, errors.New("invalid json: " + str)
+	}
+
+	return JSON{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}, nil
+}
+
+// MustJSONFromString is like JSONFromString but panics if the string cannot be parsed, like uuid.MustParse.
+// It is meant for tests and package-level variables, e.g. MustJSONFromString(`{"a":1}`), never for untrusted input.
+func MustJSONFromString(str string) JSON {
+	return must(JSONFromString(str))
+}
+
+// String output JSON
+func (s JSON) String() string {
+	// If the value is nil we return an empty string
+	if s.IsNil() {
+		return ""
+	}
+
+	return string(s.underlying)
+}
+
+// CSVValue returns the value for a CSV field, which is the same as String and an empty string for nil.
+func (s JSON) CSVValue() string {
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.String()
+}
+
+// JSON returns the json.RawMessage value.
+func (s JSON) JSON() json.RawMessage {
+	return s.underlying
+}
+
+// JSONPtr returns the json.RawMessage value as a pointer.
+func (s JSON) JSONPtr() *json.RawMessage {
+	if s.IsNil() {
+		return nil
+	}
+	return &s.underlying
+}
+
+// IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
+func (s JSON) IsDefined() bool {
+	return s.isDefined
+}
+
+// IsNil returns true if the value is nil or undefined.
+func (s JSON) IsNil() bool {
+	// if the value is undefined, it is nil even though "isNil" will be set to false
+	if !s.isDefined {
+		return true
+	}
+
+	return s.isNil
+}
+
+// IsZero checks if JSON is nil, which is specifically used by sqlboiler queries
+func (s JSON) IsZero() bool { return s.IsNil() }
+
+// Ptr returns the pointer for JSON, but returns nil if undefined.
+func (s JSON) Ptr() *JSON {
+	if !s.isDefined {
+		return nil
+	}
+
+	return &s
+}
+
+// Val returns the value of a JSON-pointer,
+// will return an undefined JSON if the pointer is nil.
+func (s *JSON) Val() JSON {
+	if s == nil {
+		return NewJSONFromPtr(nil)
+	}
+
+	return *s
+}
+
+// Set sets the JSON to the value in place, which is the same as assigning NewJSON(v).
+func (s *JSON) Set(v json.RawMessage) {
+	*s = NewJSON(v)
+}
+
+// SetNil sets the JSON to nil in place, which is the same as assigning NewJSONFromPtr(nil), so it is still defined.
+func (s *JSON) SetNil() {
+	*s = NewJSONFromPtr(nil)
+}
+
+// SetUndefined sets the JSON to undefined in place, which is the same as assigning NewJSONUndefined().
+func (s *JSON) SetUndefined() {
+	*s = NewJSONUndefined()
+}
+
+// Validate validates the JSON against the rules, see Rule.
+func (s JSON) Validate(rules ...Rule) error {
+	return validate(s, rules)
+}
+
+// Hash returns a hash of the state and the value, which can be used for change detection, see HashStruct.
+func (s JSON) Hash() uint64 {
+	return hashBinary(s)
+}
+
+// MarshalJSON implements the json Marshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Marshaler
+func (s JSON) MarshalJSON() ([]byte, error) {
+	if s.IsNil() {
+		return nullBytes, nil
+	}
+
+	jsonBytes, err := json.Marshal(s.underlying)
+	if err != nil {
+		return nil, errors.Wrap(err, s.String())
+	}
+
+	return jsonBytes, nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Unmarshaler
+func (s *JSON) UnmarshalJSON(d []byte) error {
+	s.isNil = isNullBytes(d)
+	s.isDefined = true
+
+	if s.isNil {
+		return nil
+	}
+
+	err := json.Unmarshal(d, &s.underlying)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalYAML implements the yaml Marshaler interface.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Marshaler
+func (s JSON) MarshalYAML() (interface{}, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+
+	var v interface{}
+	err := json.Unmarshal(s.underlying, &v)
+	if err != nil {
+		return nil, errors.Wrap(err, s.String())
+	}
+
+	return v, nil
+}
+
+// UnmarshalYAML implements the yaml Unmarshaler interface.
+//
+// Note that yaml.v3 does not call UnmarshalYAML for null values,
+// so a null in the YAML input leaves the value undefined.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Unmarshaler
+func (s *JSON) UnmarshalYAML(value *yaml.Node) error {
+	s.isNil = false
+	s.isDefined = true
+
+	var v interface{}
+	err := value.Decode(&v)
+	if err != nil {
+		return err
+	}
+
+	s.underlying, err = json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary implements the encoding BinaryMarshaler interface,
+// the value is encoded as a state byte (undefined, nil or defined) followed by the value.
+//
+// See: https://pkg.go.dev/encoding#BinaryMarshaler
+func (s JSON) MarshalBinary() ([]byte, error) {
+	if s.IsNil() {
+		return marshalBinaryNil(s.isDefined), nil
+	}
+
+	return append([]byte{binaryDefined}, s.underlying...), nil
+}
+
+// UnmarshalBinary implements the encoding BinaryUnmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (s *JSON) UnmarshalBinary(d []byte) error {
+	isDefined, isNil, payload, err := unmarshalBinaryState(d)
+	if err != nil {
+		return err
+	}
+
+	var underlying json.RawMessage
+	if isDefined && !isNil {
+		underlying = bytes.Clone(payload)
+	}
+
+	*s = JSON{
+		underlying: underlying,
+		isDefined:  isDefined,
+		isNil:      isNil,
+	}
+
+	return nil
+}
+
+// EncodeMsgpack implements the msgpack CustomEncoder interface,
+// nil and undefined values are encoded as an extension type to keep the state.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomEncoder
+func (s JSON) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if s.IsNil() {
+		return encodeMsgpackNil(enc, s.isDefined)
+	}
+
+	return enc.EncodeString(string(s.underlying))
+}
+
+// DecodeMsgpack implements the msgpack CustomDecoder interface.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomDecoder
+func (s *JSON) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isDefined, isNil, err := decodeMsgpackState(dec)
+	if err != nil {
+		return err
+	}
+
+	if !isDefined || isNil {
+		*s = JSON{
+			isDefined: isDefined,
+			isNil:     isNil,
+		}
+
+		return nil
+	}
+
+	underlying, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+
+	*s = JSON{
+		underlying: json.RawMessage(underlying),
+		isDefined:  true,
+		isNil:      false,
+	}
+
+	return nil
+}
+
+func (s *JSON) Marshal(obj interface{}) error {
+	res, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	s.isDefined = true
+	s.isNil = isNullBytes(res)
+	s.underlying = res
+	return nil
+}
+
+// Unmarshal unmarshals the underlying JSON into v,
+// an error is returned if the value is nil or undefined.
+func (s JSON) Unmarshal(v interface{}) error {
+	if !s.IsDefined() {
+		return errors.New("cannot unmarshal undefined JSON")
+	}
+
+	if s.IsNil() {
+		return errors.New("cannot unmarshal nil JSON")
+	}
+
+	return json.Unmarshal(s.underlying, v)
+}
+
+// EqualJSON compares the JSON structurally with the other JSON, ignoring key order and formatting.
+// Numbers are compared exactly, so large integers such as IDs are not rounded, and 1 equals 1.0.
+//
+// A nil or undefined JSON is only equal to another nil or undefined JSON,
+// so it is not equal to a defined JSON such as `null` or `{}`.
+// An error is returned if any of the values is malformed JSON.
+func (s JSON) EqualJSON(other JSON) (bool, error) {
+	if s.IsNil() || other.IsNil() {
+		return s.IsNil() && other.IsNil(), nil
+	}
+
+	a, err := decodeExactJSON(s.underlying)
+	if err != nil {
+		return false, errors.Wrap(err, "invalid json")
+	}
+
+	b, err := decodeExactJSON(other.underlying)
+	if err != nil {
+		return false, errors.Wrap(err, "invalid other json")
+	}
+
+	return reflect.DeepEqual(a, b), nil
+}
+
+// exactNumber is a JSON number in the canonical form of canonicalNumber,
+// it is a separate type so that it is not equal to a JSON string with the same content.
+type exactNumber string
+
+// decodeExactJSON decodes the JSON like json.Unmarshal into an interface{}, but the numbers are decoded
+// as exactNumber instead of float64, so e.g. integers above 2^53 are not rounded to the same value.
+func decodeExactJSON(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var v interface{}
+
+	err := decoder.Decode(&v)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after the json value")
+	}
+
+	return exactNumbers(v), nil
+}
+
+// exactNumbers replaces the json.Number values with exactNumber values.
+func exactNumbers(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		return exactNumber(canonicalNumber(v.String()))
+
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = exactNumbers(value)
+		}
+
+	case []interface{}:
+		for i, value := range v {
+			v[i] = exactNumbers(value)
+		}
+	}
+
+	return v
+}
+
+// canonicalNumber returns the JSON number as digits without leading or trailing zeros and an exponent,
+// so that equal numbers such as 1, 1.0 and 10e-1 have the same representation, e.g. "1e0".
+// Negative zero is the same as zero.
+func canonicalNumber(number string) string {
+	sign, unsigned := "", number
+	if strings.HasPrefix(number, "-") {
+		sign, unsigned = "-", number[1:]
+	}
+
+	mantissa, exponentPart, hasExponent := strings.Cut(strings.ToLower(unsigned), "e")
+	integerPart, fractionPart, _ := strings.Cut(mantissa, ".")
+
+	exponent := 0
+	if hasExponent {
+		var err error
+
+		exponent, err = strconv.Atoi(exponentPart)
+		if err != nil {
+			// The exponent is too large to be normalized, so it is only equal to the same number
+			return number
+		}
+	}
+
+	digits := strings.TrimLeft(integerPart+fractionPart, "0")
+	if digits == "" {
+		return "0"
+	}
+
+	trimmed := strings.TrimRight(digits, "0")
+	exponent += len(digits) - len(trimmed) - len(fractionPart)
+
+	return sign + trimmed + "e" + strconv.Itoa(exponent)
+}
+
+// SemanticallyEqual returns true if the JSON values are equal regardless of formatting and key order,
+// e.g. to skip audit entries for updates that only reformat the JSON. It is the same as EqualJSON.
+func (s JSON) SemanticallyEqual(other JSON) (bool, error) {
+	return s.EqualJSON(other)
+}
+
+// ChangedKeys returns the sorted top-level keys that were added, removed or changed in the other JSON object,
+// where values are compared like EqualJSON. A nil JSON is treated as an object without keys.
+// An error is returned if any of the values is not a JSON object.
+func (s JSON) ChangedKeys(other JSON) ([]string, error) {
+	a, err := s.topLevelKeys()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid json")
+	}
+
+	b, err := other.topLevelKeys()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid other json")
+	}
+
+	changed := []string{}
+
+	for key, value := range a {
+		otherValue, ok := b[key]
+		if !ok {
+			changed = append(changed, key)
+			continue
+		}
+
+		equal, err := NewJSON(value).EqualJSON(NewJSON(otherValue))
+		if err != nil {
+			return nil, err
+		}
+
+		if !equal {
+			changed = append(changed, key)
+		}
+	}
+
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			changed = append(changed, key)
+		}
+	}
+
+	sort.Strings(changed)
+
+	return changed, nil
+}
+
+// topLevelKeys returns the values of the JSON object by key, which is empty for a nil JSON.
+func (s JSON) topLevelKeys() (map[string]json.RawMessage, error) {
+	keys := map[string]json.RawMessage{}
+	if s.IsNil() {
+		return keys, nil
+	}
+
+	err := json.Unmarshal(s.underlying, &keys)
+	if err != nil {
+		return nil, err
+	}
+
+	// A JSON null unmarshals without error but is not an object
+	if keys == nil {
+		return nil, errors.New("json is not an object")
+	}
+
+	return keys, nil
+}
+
+// Compact returns a new JSON with the insignificant whitespace removed, see json.Compact.
+// A nil JSON is returned as is and an error is returned if the JSON is malformed.
+func (s JSON) Compact() (JSON, error) {
+	if s.IsNil() {
+		return s, nil
+	}
+
+	var buf bytes.Buffer
+
+	err := json.Compact(&buf, s.underlying)
+	if err != nil {
+		return JSON{}, errors.Wrap(err, "invalid json")
+	}
+
+	return NewJSON(buf.Bytes()), nil
+}
+
+// GetPointer returns the value at the RFC 6901 JSON Pointer, e.g. "/address/street" or "/items/0",
+// where "~1" in a key is an escaped "/" and "~0" is an escaped "~". The empty pointer refers to the whole document.
+//
+// A JSON null at the pointer is returned as a defined JSON `null`. An error is returned if the JSON is nil,
+// the value does not exist, the pointer is malformed or the JSON is malformed.
+//
+// See: https://datatracker.ietf.org/doc/html/rfc6901
+func (s JSON) GetPointer(ptr string) (JSON, error) {
+	if s.IsNil() {
+		return JSON{}, errors.New("cannot get pointer of nil JSON")
+	}
+
+	value, found, err := lookupPointer(s.underlying, ptr)
+	if err != nil {
+		return JSON{}, err
+	}
+
+	if !found {
+		return JSON{}, errors.New("json pointer not found: " + ptr)
+	}
+
+	return NewJSON(value), nil
+}
+
+// HasPointer returns true if a value exists at the RFC 6901 JSON Pointer, see GetPointer,
+// e.g. to validate the required paths of a stored document. A nil JSON has no values.
+// An error is returned if the pointer or the JSON is malformed.
+func (s JSON) HasPointer(ptr string) (bool, error) {
+	if s.IsNil() {
+		return false, nil
+	}
+
+	_, found, err := lookupPointer(s.underlying, ptr)
+
+	return found, err
+}
+
+// jsonPointerUnescaper unescapes a reference token, "~1" must be replaced before "~0" according to RFC 6901.
+var jsonPointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// lookupPointer returns the value at the JSON Pointer and false if it does not exist.
+func lookupPointer(document json.RawMessage, ptr string) (json.RawMessage, bool, error) {
+	if ptr == "" {
+		return document, true, nil
+	}
+
+	if !strings.HasPrefix(ptr, "/") {
+		return nil, false, errors.New(fmt.Sprintf("invalid json pointer %q, must start with /", ptr))
+	}
+
+	value := document
+
+	for _, token := range strings.Split(ptr[1:], "/") {
+		if strings.Contains(strings.ReplaceAll(strings.ReplaceAll(token, "~0", ""), "~1", ""), "~") {
+			return nil, false, errors.New(fmt.Sprintf("invalid json pointer %q, ~ must be escaped as ~0", ptr))
+		}
+
+		token = jsonPointerUnescaper.Replace(token)
+
+		switch firstByte(bytes.TrimSpace(value)) {
+		case '{':
+			var object map[string]json.RawMessage
+
+			err := json.Unmarshal(value, &object)
+			if err != nil {
+				return nil, false, errors.Wrap(err, "invalid json")
+			}
+
+			next, ok := object[token]
+			if !ok {
+				return nil, false, nil
+			}
+
+			value = next
+
+		case '[':
+			var array []json.RawMessage
+
+			err := json.Unmarshal(value, &array)
+			if err != nil {
+				return nil, false, errors.Wrap(err, "invalid json")
+			}
+
+			// Array indexes have no leading zeros, and "-" refers to the element after the last one
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(array) || strconv.Itoa(index) != token {
+				return nil, false, nil
+			}
+
+			value = array[index]
+
+		default:
+			if !json.Valid(value) {
+				return nil, false, errors.New("invalid json")
+			}
+
+			return nil, false, nil
+		}
+	}
+
+	return value, true, nil
+}
+
+// firstByte returns the first byte of b, or 0 if b is empty.
+func firstByte(b []byte) byte {
+	if len(b) == 0 {
+		return 0
+	}
+
+	return b[0]
+}
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (s *JSON) Scan(value interface{}) error {
+	s.isNil = (nil == value)
+	s.isDefined = true
+
+	if s.isNil {
+		s.underlying = nil
+		return nil
+	}
+
+	switch v := value.(type) {
+	case json.RawMessage:
+		s.underlying = bytes.Clone(v)
+	case []byte:
+		s.underlying = bytes.Clone(v)
+	case string:
+		s.underlying = json.RawMessage(v)
+	default:
+		return errors.New(fmt.Sprintf("incompatible type for json: %T", value))
+	}
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (s JSON) Value() (driver.Value, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+	return string(s.underlying), nil
+}
+
+// JSONArray is used to represent a JSON array where each element is a JSON value,
+// e.g. a jsonb column holding an array of objects.
+type JSONArray []JSON
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface,
+// the value must be a JSON array which is split into its elements.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (a *JSONArray) Scan(value interface{}) error {
+	var d []byte
+
+	switch v := value.(type) {
+	case nil:
+		*a = nil
+		return nil
+	case []byte:
+		d = v
+	case string:
+		d = []byte(v)
+	default:
+		return errors.New(fmt.Sprintf("incompatible type for JSONArray: %T", value))
+	}
+
+	if trimmed := bytes.TrimSpace(d); len(trimmed) == 0 || trimmed[0] != '[' {
+		return errors.New("cannot scan non-array JSON into JSONArray: " + string(d))
+	}
+
+	var elements []json.RawMessage
+	err := json.Unmarshal(d, &elements)
+	if err != nil {
+		return err
+	}
+
+	array := make(JSONArray, len(elements))
+	for i := range elements {
+		if isNullBytes(elements[i]) {
+			array[i] = NewJSONFromPtr(nil)
+			continue
+		}
+
+		array[i] = NewJSON(elements[i])
+	}
+
+	*a = array
+
+	return nil
+}
+
+// Value implements the driver Valuer interface,
+// the elements are assembled into a JSON array where nil elements become null.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (a JSONArray) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+
+	var b bytes.Buffer
+
+	b.WriteByte('[')
+	for i := range a {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		element, err := a[i].MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+
+		b.Write(element)
+	}
+	b.WriteByte(']')
+
+	return b.String(), nil
+}
+
+// moneyMinorUnits contains the number of decimals of the active ISO 4217 currencies,
+// the codes without a minor unit such as XAU and XDR are left out.
+var moneyMinorUnits = map[string]int{
+	"AED": 2, "AFN": 2, "ALL": 2, "AMD": 2, "ANG": 2, "AOA": 2, "ARS": 2, "AUD": 2, "AWG": 2, "AZN": 2,
+	"BAM": 2, "BBD": 2, "BDT": 2, "BGN": 2, "BHD": 3, "BIF": 0, "BMD": 2, "BND": 2, "BOB": 2, "BOV": 2,
+	"BRL": 2, "BSD": 2, "BTN": 2, "BWP": 2, "BYN": 2, "BZD": 2, "CAD": 2, "CDF": 2, "CHE": 2, "CHF": 2,
+	"CHW": 2, "CLF": 4, "CLP": 0, "CNY": 2, "COP": 2, "COU": 2, "CRC": 2, "CUC": 2, "CUP": 2, "CVE": 2,
+	"CZK": 2, "DJF": 0, "DKK": 2, "DOP": 2, "DZD": 2, "EGP": 2, "ERN": 2, "ETB": 2, "EUR": 2, "FJD": 2,
+	"FKP": 2, "GBP": 2, "GEL": 2, "GHS": 2, "GIP": 2, "GMD": 2, "GNF": 0, "GTQ": 2, "GYD": 2, "HKD": 2,
+	"HNL": 2, "HTG": 2, "HUF": 2, "IDR": 2, "ILS": 2, "INR": 2, "IQD": 3, "IRR": 2, "ISK": 0, "JMD": 2,
+	"JOD": 3, "JPY": 0, "KES": 2, "KGS": 2, "KHR": 2, "KMF": 0, "KPW": 2, "KRW": 0, "KWD": 3, "KYD": 2,
+	"KZT": 2, "LAK": 2, "LBP": 2, "LKR": 2, "LRD": 2, "LSL": 2, "LYD": 3, "MAD": 2, "MDL": 2, "MGA": 2,
+	"MKD": 2, "MMK": 2, "MNT": 2, "MOP": 2, "MRU": 2, "MUR": 2, "MVR": 2, "MWK": 2, "MXN": 2, "MXV": 2,
+	"MYR": 2, "MZN": 2, "NAD": 2, "NGN": 2, "NIO": 2, "NOK": 2, "NPR": 2, "NZD": 2, "OMR": 3, "PAB": 2,
+	"PEN": 2, "PGK": 2, "PHP": 2, "PKR": 2, "PLN": 2, "PYG": 0, "QAR": 2, "RON": 2, "RSD": 2, "RUB": 2,
+	"RWF": 0, "SAR": 2, "SBD": 2, "SCR": 2, "SDG": 2, "SEK": 2, "SGD": 2, "SHP": 2, "SLE": 2, "SLL": 2,
+	"SOS": 2, "SRD": 2, "SSP": 2, "STN": 2, "SVC": 2, "SYP": 2, "SZL": 2, "THB": 2, "TJS": 2, "TMT": 2,
+	"TND": 3, "TOP": 2, "TRY": 2, "TTD": 2, "TWD": 2, "TZS": 2, "UAH": 2, "UGX": 0, "USD": 2, "USN": 2,
+	"UYI": 0, "UYU": 2, "UYW": 4, "UZS": 2, "VED": 2, "VES": 2, "VND": 0, "VUV": 0, "WST": 2, "XAF": 0,
+	"XCD": 2, "XCG": 2, "XOF": 0, "XPF": 0, "YER": 2, "ZAR": 2, "ZMW": 2, "ZWG": 2, "ZWL": 2,
+}
+
+// Money is used to represent an amount in an ISO 4217 currency, the amount is stored in the minor unit
+// of the currency, e.g. 1250 for 12.50 SEK, to avoid rounding errors.
+//
+// In the database Money is stored as text such as "12.50 SEK",
+// use AmountMinor and Currency to store the amount and the currency in two columns.
+type Money struct {
+	amountMinor int64
+	currency    string
+	isDefined   bool
+	isNil       bool
+}
+
+// NewMoney creates a new Money object from the amount in the minor unit and the currency, e.g. NewMoney(1250, "SEK").
+// The currency is upper cased but not validated, use MoneyFromMinor for currencies that are not constants.
+func NewMoney(amountMinor int64, currency string) Money {
+	return Money{
+		amountMinor: amountMinor,
+		currency:    strings.ToUpper(currency),
+		isDefined:   true,
+		isNil:       false,
+	}
+}
+
+// MoneyFromMinor creates a new Money object from the amount in the minor unit and the currency,
+// e.g. MoneyFromMinor(1250, "sek"). The currency is upper cased and an error is returned if it is not an ISO 4217 code.
+func MoneyFromMinor(amountMinor int64, currency string) (Money, error) {
+	currency = strings.ToUpper(currency)
+
+	err := validateCurrency(currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return NewMoney(amountMinor, currency), nil
+}
+
+// NewMoneyFromPtr creates a new Money object from a pointer to the amount in the minor unit.
+func NewMoneyFromPtr(amountMinor *int64, currency string) Money {
+	if amountMinor != nil {
+		return NewMoney(*amountMinor, currency)
+	}
+
+	return Money{
+		isDefined: true,
+		isNil:     true,
+	}
+}
+
+// NewMoneyUndefined creates a new undefined Money object.
+func NewMoneyUndefined() Money {
+	return Money{}
+}
+
+func MoneyFromStringPtr(strPtr *string) (Money, error) {
+	if strPtr == nil {
+		return NewMoneyFromPtr(nil, ""), nil
+	}
+
+	return MoneyFromString(*strPtr)
+}
+
+// MoneyFromString parses the amount followed by the currency, e.g. "12.50 SEK" or "-3 JPY",
+// the amount may not have more decimals than the currency.
+func MoneyFromString(str string) (Money, error) {
+	if str == "" {
+		return NewMoneyFromPtr(nil, ""), nil
+	}
+
+	fields := strings.Fields(str)
+	if len(fields) != 2 {
+		return Money{}, errors.New("invalid money format, expected amount and currency: " + str)
+	}
+
+	currency := strings.ToUpper(fields[1])
+
+	err := validateCurrency(currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	amount := fields[0]
+	decimals := moneyDecimals(currency)
+
+	whole, fraction, _ := strings.Cut(amount, ".")
+	if len(fraction) > decimals {
+		return Money{}, errors.New(fmt.Sprintf("invalid money amount, %s has %d decimals: %s", currency, decimals, str))
+	}
+
+	amountMinor, err := strconv.ParseInt(whole+fraction+strings.Repeat("0", decimals-len(fraction)), 10, 64)
+	if err != nil || strings.HasPrefix(fraction, "-") || strings.HasPrefix(fraction, "+") {
+		return Money{}, errors.New("invalid money amount: " + str)
+	}
+
+	return NewMoney(amountMinor, currency), nil
+}
+
+// MustMoneyFromString is like MoneyFromString but panics if the string cannot be parsed, like uuid.MustParse.
+// It is meant for tests and package-level variables, e.g. MustMoneyFromString("12.50 SEK"), never for untrusted input.
+func MustMoneyFromString(str string) Money {
+	return must(MoneyFromString(str))
+}
+
+// validateCurrency returns an error if the currency is not an upper cased ISO 4217 code in moneyMinorUnits.
+func validateCurrency(currency string) error {
+	if _, ok := moneyMinorUnits[currency]; !ok {
+		return errors.New(fmt.Sprintf("invalid currency %q, expected an ISO 4217 code", currency))
+	}
+
+	return nil
+}
+
+// moneyDecimals returns the number of decimals of the currency,
+// which is 2 for a currency that is not validated, e.g. from NewMoney.
+func moneyDecimals(currency string) int {
+	if decimals, ok := moneyMinorUnits[currency]; ok {
+		return decimals
+	}
+
+	return 2
+}
+
+// String output Money, e.g. "12.50 SEK".
+func (s Money) String() string {
+	// If the value is nil we return an empty string
+	if s.IsNil() {
+		return ""
+	}
+
+	decimals := moneyDecimals(s.currency)
+
+	sign := ""
+	amount := strconv.FormatInt(s.amountMinor, 10)
+	if s.amountMinor < 0 {
+		sign = "-"
+		amount = amount[1:]
+	}
+
+	if decimals == 0 {
+		return sign + amount + " " + s.currency
+	}
+
+	if len(amount) <= decimals {
+		amount = strings.Repeat("0", decimals-len(amount)+1) + amount
+	}
+
+	return sign + amount[:len(amount)-decimals] + "." + amount[len(amount)-decimals:] + " " + s.currency
+}
+
+// CSVValue returns the value for a CSV field, which is the same as String and an empty string for nil.
+func (s Money) CSVValue() string {
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.String()
+}
+
+// AmountMinor returns the amount in the minor unit of the currency, e.g. 1250 for 12.50 SEK.
+func (s Money) AmountMinor() int64 {
+	return s.amountMinor
+}
+
+// Currency returns the ISO 4217 currency code, e.g. "SEK".
+func (s Money) Currency() string {
+	return s.currency
+}
+
+// IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
+func (s Money) IsDefined() bool {
+	return s.isDefined
+}
+
+// IsNil returns true if the value is nil or undefined.
+func (s Money) IsNil() bool {
+	// if the value is undefined, it is nil even though "isNil" will be set to false
+	if !s.isDefined {
+		return true
+	}
+
+	return s.isNil
+}
+
+// IsZero checks if Money is nil, which is specifically used by sqlboiler queries
+func (s Money) IsZero() bool { return s.IsNil() }
+
+// Ptr returns the pointer for Money, but returns nil if undefined.
+func (s Money) Ptr() *Money {
+	if !s.isDefined {
+		return nil
+	}
+
+	return &s
+}
+
+// Val returns the value of a Money-pointer,
+// will return an undefined Money if the pointer is nil.
+func (s *Money) Val() Money {
+	if s == nil {
+		return NewMoneyFromPtr(nil, "")
+	}
+
+	return *s
+}
+
+// Set sets the Money to the amount and currency in place, which is the same as assigning NewMoney(amountMinor, currency).
+func (s *Money) Set(amountMinor int64, currency string) {
+	*s = NewMoney(amountMinor, currency)
+}
+
+// SetNil sets the Money to nil in place, which is the same as assigning NewMoneyFromPtr(nil, ""), so it is still defined.
+func (s *Money) SetNil() {
+	*s = NewMoneyFromPtr(nil, "")
+}
+
+// SetUndefined sets the Money to undefined in place, which is the same as assigning NewMoneyUndefined().
+func (s *Money) SetUndefined() {
+	*s = NewMoneyUndefined()
+}
+
+// Validate validates the Money against the rules, see Rule.
+func (s Money) Validate(rules ...Rule) error {
+	return validate(s, rules)
+}
+
+// Compare implements the Ordered interface, nil values sort before all non-nil values.
+// An error is returned if the currencies differ.
+func (s Money) Compare(other any) (int, error) {
+	o, ok := other.(Money)
+	if ok && !s.IsNil() && !o.IsNil() && s.currency != o.currency {
+		return 0, errors.New(fmt.Sprintf("cannot compare %s with %s", s.currency, o.currency))
+	}
+
+	return compareAny(s, other, func(a, b Money) int { return cmp.Compare(a.amountMinor, b.amountMinor) })
+}
+
+// Hash returns a hash of the state and the value, which can be used for change detection, see HashStruct.
+func (s Money) Hash() uint64 {
+	return hashBinary(s)
+}
+
+// Add returns the sum of the Money, if any of them is nil a nil Money is returned.
+// An error is returned if the currencies differ or if the amount overflows.
+func (s Money) Add(other Money) (Money, error) {
+	if s.IsNil() || other.IsNil() {
+		return NewMoneyFromPtr(nil, ""), nil
+	}
+
+	if s.currency != other.currency {
+		return Money{}, errors.New(fmt.Sprintf("cannot add %s to %s", other.currency, s.currency))
+	}
+
+	sum := s.amountMinor + other.amountMinor
+	if (other.amountMinor > 0 && sum < s.amountMinor) || (other.amountMinor < 0 && sum > s.amountMinor) {
+		return Money{}, errors.New(fmt.Sprintf("cannot add %s to %s, the amount overflows", other, s))
+	}
+
+	return NewMoney(sum, s.currency), nil
+}
+
+// Sub returns the difference of the Money, if any of them is nil a nil Money is returned.
+// An error is returned if the currencies differ or if the amount overflows.
+func (s Money) Sub(other Money) (Money, error) {
+	if s.IsNil() || other.IsNil() {
+		return NewMoneyFromPtr(nil, ""), nil
+	}
+
+	if s.currency != other.currency {
+		return Money{}, errors.New(fmt.Sprintf("cannot subtract %s from %s", other.currency, s.currency))
+	}
+
+	difference := s.amountMinor - other.amountMinor
+	if (other.amountMinor > 0 && difference > s.amountMinor) || (other.amountMinor < 0 && difference < s.amountMinor) {
+		return Money{}, errors.New(fmt.Sprintf("cannot subtract %s from %s, the amount overflows", other, s))
+	}
+
+	return NewMoney(difference, s.currency), nil
+}
+
+// moneyJSON is the JSON and YAML representation of Money.
+type moneyJSON struct {
+	Amount   int64  `json:"amount" yaml:"amount"`
+	Currency string `json:"currency" yaml:"currency"`
+}
+
+// fromMoneyJSON sets the Money from the JSON or YAML representation.
+func (s *Money) fromMoneyJSON(m moneyJSON) error {
+	currency := strings.ToUpper(m.Currency)
+
+	err := validateCurrency(currency)
+	if err != nil {
+		return err
+	}
+
+	s.amountMinor = m.Amount
+	s.currency = currency
+
+	return nil
+}
+
+// MarshalJSON implements the json Marshaler interface,
+// the amount is in the minor unit, e.g. {"amount": 1250, "currency": "SEK"}.
+//
+// See: https://pkg.go.dev/encoding/json#Marshaler
+func (s Money) MarshalJSON() ([]byte, error) {
+	if s.IsNil() {
+		return nullBytes, nil
+	}
+
+	jsonBytes, err := json.Marshal(moneyJSON{Amount: s.amountMinor, Currency: s.currency})
+	if err != nil {
+		return nil, errors.Wrap(err, s.String())
+	}
+
+	return jsonBytes, nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Unmarshaler
+func (s *Money) UnmarshalJSON(d []byte) error {
+	s.isNil = isNullBytes(d)
+	s.isDefined = true
+
+	if s.isNil {
+		return nil
+	}
+
+	var m moneyJSON
+
+	err := json.Unmarshal(d, &m)
+	if err != nil {
+		return err
+	}
+
+	return s.fromMoneyJSON(m)
+}
+
+// MarshalYAML implements the yaml Marshaler interface.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Marshaler
+func (s Money) MarshalYAML() (interface{}, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+
+	return moneyJSON{Amount: s.amountMinor, Currency: s.currency}, nil
+}
+
+// UnmarshalYAML implements the yaml Unmarshaler interface.
+//
+// Note that yaml.v3 does not call UnmarshalYAML for null values,
+// so a null in the YAML input leaves the value undefined.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Unmarshaler
+func (s *Money) UnmarshalYAML(value *yaml.Node) error {
+	s.isNil = false
+	s.isDefined = true
+
+	var m moneyJSON
+
+	err := value.Decode(&m)
+	if err != nil {
+		return err
+	}
+
+	return s.fromMoneyJSON(m)
+}
+
+// MarshalBinary implements the encoding BinaryMarshaler interface,
+// the value is encoded as a state byte (undefined, nil or defined) followed by the amount and the currency.
+//
+// See: https://pkg.go.dev/encoding#BinaryMarshaler
+func (s Money) MarshalBinary() ([]byte, error) {
+	if s.IsNil() {
+		return marshalBinaryNil(s.isDefined), nil
+	}
+
+	return append(binary.BigEndian.AppendUint64([]byte{binaryDefined}, uint64(s.amountMinor)), s.currency...), nil
+}
+
+// UnmarshalBinary implements the encoding BinaryUnmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (s *Money) UnmarshalBinary(d []byte) error {
+	isDefined, isNil, payload, err := unmarshalBinaryState(d)
+	if err != nil {
+		return err
+	}
+
+	if !isDefined || isNil {
+		*s = Money{
+			isDefined: isDefined,
+			isNil:     isNil,
+		}
+
+		return nil
+	}
+
+	err = checkBinaryLength(payload, 11)
+	if err != nil {
+		return err
+	}
+
+	money, err := MoneyFromMinor(int64(binary.BigEndian.Uint64(payload)), string(payload[8:]))
+	if err != nil {
+		return err
+	}
+
+	*s = money
+
+	return nil
+}
+
+// EncodeMsgpack implements the msgpack CustomEncoder interface,
+// nil and undefined values are encoded as an extension type to keep the state.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomEncoder
+func (s Money) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if s.IsNil() {
+		return encodeMsgpackNil(enc, s.isDefined)
+	}
+
+	err := enc.EncodeArrayLen(2)
+	if err != nil {
+		return err
+	}
+
+	err = enc.EncodeInt(s.amountMinor)
+	if err != nil {
+		return err
+	}
+
+	return enc.EncodeString(s.currency)
+}
+
+// DecodeMsgpack implements the msgpack CustomDecoder interface.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomDecoder
+func (s *Money) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isDefined, isNil, err := decodeMsgpackState(dec)
+	if err != nil {
+		return err
+	}
+
+	if !isDefined || isNil {
+		*s = Money{
+			isDefined: isDefined,
+			isNil:     isNil,
+		}
+
+		return nil
+	}
+
+	length, err := dec.DecodeArrayLen()
+	if err != nil {
+		return err
+	}
+
+	if length != 2 {
+		return errors.New(fmt.Sprintf("invalid msgpack data: expected amount and currency, got %d values", length))
+	}
+
+	amountMinor, err := dec.DecodeInt64()
+	if err != nil {
+		return err
+	}
+
+	currency, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+
+	money, err := MoneyFromMinor(amountMinor, currency)
+	if err != nil {
+		return err
+	}
+
+	*s = money
+
+	return nil
+}
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface,
+// the value is parsed with MoneyFromString, e.g. "12.50 SEK".
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (s *Money) Scan(value interface{}) error {
+	if value == nil {
+		*s = NewMoneyFromPtr(nil, "")
+		return nil
+	}
+
+	var str string
+
+	err := convert.ConvertAssign(&str, value)
+	if err != nil {
+		return err
+	}
+
+	*s, err = MoneyFromString(str)
+	return err
+}
+
+// Value implements the driver Valuer interface, the value is stored as text, e.g. "12.50 SEK".
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (s Money) Value() (driver.Value, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+	return s.String(), nil
+}
+
+// Nullable is a generic type with the same defined/nil/undefined semantics as the concrete types in this package.
+//
+// It can be used by other packages to build their own types, e.g. Nullable[decimal.Decimal],
+// without copying the state handling. The concrete types in this package are kept for API stability.
+//
+// Scan and Value are implemented on a best effort basis since driver values can't be converted fully generic:
+// Scan assigns the database value with the same conversion rules as the concrete types (see convert.ConvertAssign),
+// which supports the basic kinds and any T implementing the sql Scanner interface,
+// and Value converts the underlying value with driver.DefaultParameterConverter,
+// which supports the basic kinds and any T implementing the driver Valuer interface.
+// Other types of T will return an error from Scan and Value.
+type Nullable[T any] struct {
+	underlying T
+	isDefined  bool
+	isNil      bool
+}
+
+// NewNullable creates a new Nullable object.
+func NewNullable[T any](underlying T) Nullable[T] {
+	return Nullable[T]{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}
+}
+
+// NewNullableFromPtr creates a new Nullable object from a pointer.
+func NewNullableFromPtr[T any](underlying *T) Nullable[T] {
+	if underlying != nil {
+		return NewNullable(*underlying)
+	}
+
+	return Nullable[T]{
+		isDefined: true,
+		isNil:     true,
+	}
+}
+
+// NewNullableUndefined creates a new undefined Nullable object.
+func NewNullableUndefined[T any]() Nullable[T] {
+	return Nullable[T]{}
+}
+
+// Underlying returns the underlying value.
+func (s Nullable[T]) Underlying() T {
+	return s.underlying
+}
+
+// UnderlyingPtr returns the underlying value as a pointer.
+func (s Nullable[T]) UnderlyingPtr() *T {
+	if s.IsNil() {
+		return nil
+	}
+	return &s.underlying
+}
+
+// IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
+func (s Nullable[T]) IsDefined() bool {
+	return s.isDefined
+}
+
+// IsNil returns true if the value is nil or undefined.
+func (s Nullable[T]) IsNil() bool {
+	// if the value is undefined, it is nil even though "isNil" will be set to false
+	if !s.isDefined {
+		return true
+	}
+
+	return s.isNil
+}
+
+// IsZero checks if Nullable is nil, which is specifically used by sqlboiler queries
+func (s Nullable[T]) IsZero() bool { return s.IsNil() }
+
+// Ptr returns the pointer for Nullable, but returns nil if undefined.
+func (s Nullable[T]) Ptr() *Nullable[T] {
+	if !s.isDefined {
+		return nil
+	}
+
+	return &s
+}
+
+// Val returns the value of a Nullable-pointer,
+// will return an undefined Nullable if the pointer is nil.
+func (s *Nullable[T]) Val() Nullable[T] {
+	if s == nil {
+		return NewNullableFromPtr[T](nil)
+	}
+
+	return *s
+}
+
+// Set sets the Nullable to the value in place, which is the same as assigning NewNullable(v).
+func (s *Nullable[T]) Set(v T) {
+	*s = NewNullable(v)
+}
+
+// SetNil sets the Nullable to nil in place, which is the same as assigning NewNullableFromPtr[T](nil), so it is still defined.
+func (s *Nullable[T]) SetNil() {
+	*s = NewNullableFromPtr[T](nil)
+}
+
+// SetUndefined sets the Nullable to undefined in place, which is the same as assigning NewNullableUndefined[T]().
+func (s *Nullable[T]) SetUndefined() {
+	*s = NewNullableUndefined[T]()
+}
+
+// MarshalJSON implements the json Marshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Marshaler
+func (s Nullable[T]) MarshalJSON() ([]byte, error) {
+	if s.IsNil() {
+		return nullBytes, nil
+	}
+
+	jsonBytes, err := json.Marshal(s.underlying)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("%v", s.underlying))
+	}
+
+	return jsonBytes, nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Unmarshaler
+func (s *Nullable[T]) UnmarshalJSON(d []byte) error {
+	s.isNil = isNullBytes(d)
+	s.isDefined = true
+
+	if s.isNil {
+		var zero T
+		s.underlying = zero
+		return nil
+	}
+
+	err := json.Unmarshal(d, &s.underlying)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// EncodeMsgpack implements the msgpack CustomEncoder interface,
+// nil and undefined values are encoded as an extension type to keep the state.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomEncoder
+func (s Nullable[T]) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if s.IsNil() {
+		return encodeMsgpackNil(enc, s.isDefined)
+	}
+
+	return enc.Encode(s.underlying)
+}
+
+// DecodeMsgpack implements the msgpack CustomDecoder interface.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomDecoder
+func (s *Nullable[T]) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isDefined, isNil, err := decodeMsgpackState(dec)
+	if err != nil {
+		return err
+	}
+
+	*s = Nullable[T]{
+		isDefined: isDefined,
+		isNil:     isNil,
+	}
+
+	if !isDefined || isNil {
+		return nil
+	}
+
+	return dec.Decode(&s.underlying)
+}
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (s *Nullable[T]) Scan(value interface{}) error {
+	s.isNil = (nil == value)
+	s.isDefined = true
+
+	if s.isNil {
+		var zero T
+		s.underlying = zero
+		return nil
+	}
+
+	return convert.ConvertAssign(&s.underlying, value)
+}
+
+// Value implements the driver Valuer interface.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (s Nullable[T]) Value() (driver.Value, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(s.underlying)
+}
+
+// PercentMode decides how a Percent is represented in JSON and YAML, see SetPercentMarshalMode.
+type PercentMode int
+
+const (
+	// PercentWhole represents 42.5% as 42.5.
+	PercentWhole PercentMode = iota
+	// PercentFraction represents 42.5% as 0.425.
+	PercentFraction
+)
+
+// percentMarshalMode decides how a Percent is marshaled to and unmarshaled from JSON and YAML,
+// see SetPercentMarshalMode.
+var percentMarshalMode = newSetting(PercentWhole)
+
+// SetPercentMarshalMode sets how a Percent is marshaled to and unmarshaled from JSON and YAML,
+// the default is PercentWhole. The database always stores the whole percentage, e.g. 42.5.
+//
+// It is meant to be called once at startup, since it changes the JSON and YAML of all Percent values,
+// but it is safe for concurrent use.
+func SetPercentMarshalMode(mode PercentMode) {
+	percentMarshalMode.set(mode)
+}
+
+// Percent is used to represent percentages, the underlying value is the whole percentage, e.g. 42.5 for 42.5%.
+type Percent struct {
+	underlying float64
+	isDefined  bool
+	isNil      bool
+}
+
+// NewPercent creates a new Percent object from the whole percentage, e.g. 42.5 for 42.5%.
+func NewPercent(underlying float64) Percent {
+	return Percent{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}
+}
+
+// NewPercentFromPtr creates a new Percent object from a pointer.
+func NewPercentFromPtr(underlying *float64) Percent {
+	if underlying != nil {
+		return NewPercent(*underlying)
+	}
+
+	return Percent{
+		isDefined: true,
+		isNil:     true,
+	}
+}
+
+// NewPercentFromFraction creates a new Percent object from the fraction, e.g. 0.425 for 42.5%.
+func NewPercentFromFraction(fraction float64) Percent {
+	return NewPercent(fraction * 100)
+}
+
+// NewPercentUndefined creates a new undefined Percent object.
+func NewPercentUndefined() Percent {
+	return Percent{}
+}
+
+func PercentFromStringPtr(strPtr *string) (Percent, error) {
+	if strPtr == nil {
+		return NewPercentFromPtr(nil), nil
+	}
+
+	return PercentFromString(*strPtr)
+}
+
+// PercentFromString parses the whole percentage with or without a percent sign, e.g. "42.5" or "42.5%",
+// an error is returned for NaN and infinity.
+func PercentFromString(str string) (Percent, error) {
+	if str == "" {
+		return NewPercentFromPtr(nil), nil
+	}
+
+	underlying, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(str), "%")), 64)
+	if err != nil {
+		return Percent{}, err
+	}
+
+	if math.IsNaN(underlying) || math.IsInf(underlying, 0) {
+		return Percent{}, errors.New("invalid percentage, expected a finite number: " + str)
+	}
+
+	return Percent{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}, nil
+}
+
+// MustPercentFromString is like PercentFromString but panics if the string cannot be parsed, like uuid.MustParse.
+// It is meant for tests and package-level variables, e.g. MustPercentFromString("42.5%"), never for untrusted input.
+func MustPercentFromString(str string) Percent {
+	return must(PercentFromString(str))
+}
+
+// PercentFromSwedishString parses a percentage from Swedish input, where the decimal separator is a comma,
+// with or without a percent sign, e.g. "85,5%" or "85,5". A dot is not accepted as the decimal separator,
+// and an error is returned if the percentage is not within 0 to 100.
+func PercentFromSwedishString(str string) (Percent, error) {
+	if str == "" {
+		return NewPercentFromPtr(nil), nil
+	}
+
+	trimmed := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(str), "%"))
+	if trimmed == "" || strings.Contains(trimmed, ".") {
+		return Percent{}, errors.New(fmt.Sprintf("invalid swedish percentage, the decimal separator must be a comma: %s", str))
+	}
+
+	p, err := PercentFromString(strings.Replace(trimmed, ",", ".", 1))
+	if err != nil {
+		return Percent{}, errors.New(fmt.Sprintf("invalid swedish percentage: %s", str))
+	}
+
+	if p.underlying < 0 || p.underlying > 100 {
+		return Percent{}, errors.New(fmt.Sprintf("percentage %s is not within 0 to 100", str))
+	}
+
+	return p, nil
+}
+
+// String output Percent, with at most two decimals and a percent sign, e.g. "42.5%".
+func (s Percent) String() string {
+	// If the value is nil we return an empty string
+	if s.IsNil() {
+		return ""
+	}
+
+	return NewFloat64(s.underlying).Format(Float64FormatOptions{
+		DecimalSeparator: ".",
+		Precision:        2,
+	}) + "%"
+}
+
+// CSVValue returns the value for a CSV field, which is the same as String and an empty string for nil.
+func (s Percent) CSVValue() string {
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.String()
+}
+
+// Percent returns the whole percentage, e.g. 42.5 for 42.5%.
+func (s Percent) Percent() float64 {
+	return s.underlying
+}
+
+// PercentPtr returns the whole percentage as a pointer.
+func (s Percent) PercentPtr() *float64 {
+	if s.IsNil() {
+		return nil
+	}
+	return &s.underlying
+}
+
+// Fraction returns the percentage as a fraction, e.g. 0.425 for 42.5%.
+func (s Percent) Fraction() float64 {
+	return s.underlying / 100
+}
+
+// IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
+func (s Percent) IsDefined() bool {
+	return s.isDefined
+}
+
+// IsNil returns true if the value is nil or undefined.
+func (s Percent) IsNil() bool {
+	// if the value is undefined, it is nil even though "isNil" will be set to false
+	if !s.isDefined {
+		return true
+	}
+
+	return s.isNil
+}
+
+// IsZero checks if Percent is nil, which is specifically used by sqlboiler queries
+func (s Percent) IsZero() bool { return s.IsNil() }
+
+// Ptr returns the pointer for Percent, but returns nil if undefined.
+func (s Percent) Ptr() *Percent {
+	if !s.isDefined {
+		return nil
+	}
+
+	return &s
+}
+
+// Val returns the value of a Percent-pointer,
+// will return an undefined Percent if the pointer is nil.
+func (s *Percent) Val() Percent {
+	if s == nil {
+		return NewPercentFromPtr(nil)
+	}
+
+	return *s
+}
+
+// Set sets the Percent to the value in place, which is the same as assigning NewPercent(v).
+func (s *Percent) Set(v float64) {
+	*s = NewPercent(v)
+}
+
+// SetNil sets the Percent to nil in place, which is the same as assigning NewPercentFromPtr(nil), so it is still defined.
+func (s *Percent) SetNil() {
+	*s = NewPercentFromPtr(nil)
+}
+
+// SetUndefined sets the Percent to undefined in place, which is the same as assigning NewPercentUndefined().
+func (s *Percent) SetUndefined() {
+	*s = NewPercentUndefined()
+}
+
+// Validate validates the Percent against the rules, see Rule.
+func (s Percent) Validate(rules ...Rule) error {
+	return validate(s, rules)
+}
+
+// Compare implements the Ordered interface, nil values sort before all non-nil values.
+func (s Percent) Compare(other any) (int, error) {
+	return compareAny(s, other, func(a, b Percent) int { return cmp.Compare(a.underlying, b.underlying) })
+}
+
+// Hash returns a hash of the state and the value, which can be used for change detection, see HashStruct.
+func (s Percent) Hash() uint64 {
+	return hashBinary(s)
+}
+
+// marshaled returns the number used for JSON and YAML according to the marshal mode, see SetPercentMarshalMode.
+func (s Percent) marshaled() float64 {
+	if percentMarshalMode.get() == PercentFraction {
+		return s.Fraction()
+	}
+
+	return s.underlying
+}
+
+// unmarshaled sets the whole percentage from a number in JSON or YAML according to the marshal mode, see SetPercentMarshalMode.
+func (s *Percent) unmarshaled(number float64) {
+	if percentMarshalMode.get() == PercentFraction {
+		number *= 100
+	}
+
+	s.underlying = number
+}
+
+// MarshalJSON implements the json Marshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Marshaler
+func (s Percent) MarshalJSON() ([]byte, error) {
+	if s.IsNil() {
+		return nullBytes, nil
+	}
+
+	jsonBytes, err := json.Marshal(s.marshaled())
+	if err != nil {
+		return nil, errors.Wrap(err, s.String())
+	}
+
+	return jsonBytes, nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Unmarshaler
+func (s *Percent) UnmarshalJSON(d []byte) error {
+	s.isNil = isNullBytes(d)
+	s.isDefined = true
+
+	if s.isNil {
+		return nil
+	}
+
+	var number float64
+	err := json.Unmarshal(d, &number)
+	if err != nil {
+		return err
+	}
+
+	s.unmarshaled(number)
+
+	return nil
+}
+
+// MarshalYAML implements the yaml Marshaler interface.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Marshaler
+func (s Percent) MarshalYAML() (interface{}, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+
+	return s.marshaled(), nil
+}
+
+// UnmarshalYAML implements the yaml Unmarshaler interface.
+//
+// Note that yaml.v3 does not call UnmarshalYAML for null values,
+// so a null in the YAML input leaves the value undefined.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Unmarshaler
+func (s *Percent) UnmarshalYAML(value *yaml.Node) error {
+	s.isNil = false
+	s.isDefined = true
+
+	var number float64
+	err := value.Decode(&number)
+	if err != nil {
+		return err
+	}
+
+	s.unmarshaled(number)
+
+	return nil
+}
+
+// MarshalBinary implements the encoding BinaryMarshaler interface,
+// the value is encoded as a state byte (undefined, nil or defined) followed by the value.
+//
+// See: https://pkg.go.dev/encoding#BinaryMarshaler
+func (s Percent) MarshalBinary() ([]byte, error) {
+	if s.IsNil() {
+		return marshalBinaryNil(s.isDefined), nil
+	}
+
+	return append([]byte{binaryDefined}, binary.BigEndian.AppendUint64(nil, math.Float64bits(s.underlying))...), nil
+}
+
+// UnmarshalBinary implements the encoding BinaryUnmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (s *Percent) UnmarshalBinary(d []byte) error {
+	isDefined, isNil, payload, err := unmarshalBinaryState(d)
+	if err != nil {
+		return err
+	}
+
+	var underlying float64
+	if isDefined && !isNil {
+		err := checkBinaryLength(payload, 8)
+		if err != nil {
+			return err
+		}
+
+		underlying = math.Float64frombits(binary.BigEndian.Uint64(payload))
+	}
+
+	*s = Percent{
+		underlying: underlying,
+		isDefined:  isDefined,
+		isNil:      isNil,
+	}
+
+	return nil
+}
+
+// EncodeMsgpack implements the msgpack CustomEncoder interface,
+// nil and undefined values are encoded as an extension type to keep the state.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomEncoder
+func (s Percent) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if s.IsNil() {
+		return encodeMsgpackNil(enc, s.isDefined)
+	}
+
+	return enc.EncodeFloat64(s.underlying)
+}
+
+// DecodeMsgpack implements the msgpack CustomDecoder interface.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomDecoder
+func (s *Percent) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isDefined, isNil, err := decodeMsgpackState(dec)
+	if err != nil {
+		return err
+	}
+
+	if !isDefined || isNil {
+		*s = Percent{
+			isDefined: isDefined,
+			isNil:     isNil,
+		}
+
+		return nil
+	}
+
+	underlying, err := dec.DecodeFloat64()
+	if err != nil {
+		return err
+	}
+
+	*s = Percent{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}
+
+	return nil
+}
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface,
+// the database value is the whole percentage.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (s *Percent) Scan(value interface{}) error {
+	s.isNil = (nil == value)
+	s.isDefined = true
+
+	if s.isNil {
+		s.underlying = 0
+		return nil
+	}
+
+	return convert.ConvertAssign(&s.underlying, value)
+}
+
+// Value implements the driver Valuer interface, the whole percentage is stored.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (s Percent) Value() (driver.Value, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+	return s.underlying, nil
+}
+
+// RichText is used to represent rich text.
+type RichText struct {
+	underlying string
+	isDefined  bool
+	isNil      bool
+}
+
+// NewRichText creates a new RichText object, the content is stored as is.
+// Use RichTextFromString or Sanitize for content that is not trusted.
+func NewRichText(underlying string) RichText {
+	return RichText{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}
+}
+
+// NewRichTextFromPtr creates a new RichText object from a pointer.
+func NewRichTextFromPtr(underlying *string) RichText {
+	if underlying != nil {
+		return NewRichText(*underlying)
+	}
+
+	return RichText{
+		isDefined: true,
+		isNil:     true,
+	}
+}
+
+// NewRichTextUndefined creates a new undefined RichText object.
+func NewRichTextUndefined() RichText {
+	return RichText{}
+}
+
+func RichTextFromStringPtr(strPtr *string) (RichText, error) {
+	if strPtr == nil {
+		return NewRichTextFromPtr(nil), nil
+	}
+
+	return RichTextFromString(*strPtr)
+}
+
+// RichTextFromString creates a new RichText object from the string, the content is sanitized (see Sanitize).
+func RichTextFromString(str string) (RichText, error) {
+	if str == "" {
+		return NewRichTextFromPtr(nil), nil
+	}
+
+	underlying, err := sanitizeHTML(strings.TrimSpace(str))
+	if err != nil {
+		return RichText{}, err
+	}
+
+	return RichText{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}, nil
+}
+
+// String output RichText
+func (s RichText) String() string {
+	// If the value is nil we return an empty string
+	if s.IsNil() {
+		return ""
+	}
+
+	return string(s.underlying)
+}
+
+// CSVValue returns the value for a CSV field, which is the same as String and an empty string for nil.
+func (s RichText) CSVValue() string {
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.String()
+}
+
+// RichText returns the string value.
+func (s RichText) RichText() string {
+	return s.underlying
+}
+
+// RichTextPtr returns the string value as a pointer.
+func (s RichText) RichTextPtr() *string {
+	if s.IsNil() {
+		return nil
+	}
+	return &s.underlying
+}
+
+// IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
+func (s RichText) IsDefined() bool {
+	return s.isDefined
+}
+
+// IsNil returns true if the value is nil or undefined.
+func (s RichText) IsNil() bool {
+	// if the value is undefined, it is nil even though "isNil" will be set to false
+	if !s.isDefined {
+		return true
+	}
+
+	return s.isNil
+}
+
+// IsZero checks if RichText is nil, which is specifically used by sqlboiler queries
+func (s RichText) IsZero() bool { return s.IsNil() }
+
+// IsEmpty returns true if the RichText is nil, undefined or the plain text only contains whitespace,
+// e.g. "<p></p>" or "<p> <br></p>". Note that content without text, such as only an image, is empty as well.
+func (s RichText) IsEmpty() bool {
+	if s.IsNil() {
+		return true
+	}
+
+	text, err := s.Text()
+	if err != nil {
+		return strings.TrimSpace(s.underlying) == ""
+	}
+
+	return strings.TrimSpace(text) == ""
+}
+
+// Ptr returns the pointer for RichText, but returns nil if undefined.
+func (s RichText) Ptr() *RichText {
+	if !s.isDefined {
+		return nil
+	}
+
+	return &s
+}
+
+// Val returns the value of a RichText-pointer,
+// will return an undefined RichText if the pointer is nil.
+func (s *RichText) Val() RichText {
+	if s == nil {
+		return NewRichTextFromPtr(nil)
+	}
+
+	return *s
+}
+
+// Set sets the RichText to the value in place, which is the same as assigning NewRichText(v).
+func (s *RichText) Set(v string) {
+	*s = NewRichText(v)
+}
+
+// SetNil sets the RichText to nil in place, which is the same as assigning NewRichTextFromPtr(nil), so it is still defined.
+func (s *RichText) SetNil() {
+	*s = NewRichTextFromPtr(nil)
+}
+
+// SetUndefined sets the RichText to undefined in place, which is the same as assigning NewRichTextUndefined().
+func (s *RichText) SetUndefined() {
+	*s = NewRichTextUndefined()
+}
+
+// Validate validates the RichText against the rules, see Rule.
+func (s RichText) Validate(rules ...Rule) error {
+	return validate(s, rules)
+}
+
+// Hash returns a hash of the state and the value, which can be used for change detection, see HashStruct.
+func (s RichText) Hash() uint64 {
+	return hashBinary(s)
+}
+
+// RichTextToLower returns the underlying value of RichText in lower case.
+func RichTextToLower(s RichText) RichText {
+	if !s.IsNil() {
+		s.underlying = strings.ToLower(s.underlying)
+	}
+
+	return s
+}
+
+// RichTextsToLower returns the underlying values of RichTexts in lower case.
+func RichTextsToLower(s []RichText) []RichText {
+	for i := range s {
+		s[i].underlying = strings.ToLower(s[i].underlying)
+	}
+
+	return s
+}
+
+// Text returns the plain text value of the rich text.
+//
+// The method basically converts HTML content to plain text,
+// removing all HTML tags and unescaping HTML entities.
+//
+// For example, "<p>Hello my &lt;b&gt;friend&lt;/b&gt;</p>" becomes "Hello my <b>friend</b>".
+func (s RichText) Text() (string, error) {
+	doc, err := html.Parse(strings.NewReader(s.underlying))
+	if err != nil {
+		return "", err
+	}
+
+	// walkNodes recursively traverses the HTML node tree and extracts text from text nodes
+	var walkNodes func(b *bytes.Buffer, n *html.Node) error
+
+	walkNodes = func(b *bytes.Buffer, n *html.Node) error {
+		if n.Type == html.TextNode {
+			_, err := b.WriteString(n.Data)
+			if err != nil {
+				return err
+			}
+		}
+
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if err := walkNodes(b, c); err != nil {
+				return err
+			}
+		}
+
+		// Add double newlines for specific closing tags unless it's the last node
+		if n.Type == html.ElementNode {
+			switch n.Data {
+			case "p", "h1", "h2", "h3", "pre", "ul", "ol":
+				if n.NextSibling != nil || n.Parent.NextSibling != nil {
+					_, err := b.WriteString("\n\n")
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		return nil
+	}
+
+	var b bytes.Buffer
+	if err := walkNodes(&b, doc); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(b.String(), "\n\n"), nil
+}
+
+// Markdown returns the rich text converted to Markdown.
+//
+// Paragraphs and blocks are separated by blank lines, <strong> becomes **bold**, <em> becomes _italic_,
+// <h1>-<h3> become "#"-headings, <ul> and <ol> become "- " and "1. " lists (nested lists are indented),
+// <pre><code> becomes a fenced code block and <a> becomes [text](href).
+// Empty paragraphs are removed.
+func (s RichText) Markdown() (string, error) {
+	doc, err := html.Parse(strings.NewReader(s.underlying))
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(markdownBlocks(doc), "\n\n"), nil
+}
+
+// markdownBlocks returns the Markdown blocks for the children of the given node,
+// consecutive inline nodes are grouped into a paragraph.
+func markdownBlocks(n *html.Node) []string {
+	var blocks []string
+	var inline strings.Builder
+
+	flushInline := func() {
+		if paragraph := strings.TrimSpace(inline.String()); paragraph != "" {
+			blocks = append(blocks, paragraph)
+		}
+		inline.Reset()
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.TextNode || (c.Type == html.ElementNode && isMarkdownInline(c.Data)) {
+			inline.WriteString(markdownInline(c))
+			continue
+		}
+
+		flushInline()
+
+		if c.Type != html.ElementNode && c.Type != html.DocumentNode {
+			continue
+		}
+
+		switch c.Data {
+		case "p":
+			if paragraph := strings.TrimSpace(markdownInlineChildren(c)); paragraph != "" {
+				blocks = append(blocks, paragraph)
+			}
+
+		case "h1", "h2", "h3":
+			if heading := strings.TrimSpace(markdownInlineChildren(c)); heading != "" {
+				level := int(c.Data[1] - '0')
+				blocks = append(blocks, strings.Repeat("#", level)+" "+heading)
+			}
+
+		case "pre":
+			code := strings.TrimSuffix(htmlText(c), "\n")
+			blocks = append(blocks, "```\n"+code+"\n```")
+
+		case "ul", "ol":
+			if list := markdownList(c); list != "" {
+				blocks = append(blocks, list)
+			}
+
+		default:
+			blocks = append(blocks, markdownBlocks(c)...)
+		}
+	}
+
+	flushInline()
+
+	return blocks
+}
+
+// markdownList returns the Markdown for the items of a <ul> or <ol> list,
+// where nested blocks of an item are indented to align with the text of the item.
+func markdownList(n *html.Node) string {
+	var items []string
+
+	number := 0
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type != html.ElementNode || c.Data != "li" {
+			continue
+		}
+
+		number++
+
+		marker := "- "
+		if n.Data == "ol" {
+			marker = fmt.Sprintf("%d. ", number)
+		}
+
+		blocks := markdownBlocks(c)
+		if len(blocks) == 0 {
+			continue
+		}
+
+		indent := strings.Repeat(" ", len(marker))
+		item := marker + strings.ReplaceAll(strings.Join(blocks, "\n"), "\n", "\n"+indent)
+
+		items = append(items, item)
+	}
+
+	return strings.Join(items, "\n")
+}
+
+func isMarkdownInline(tag string) bool {
+	switch tag {
+	case "a", "b", "br", "code", "em", "i", "img", "s", "span", "strong", "u":
+		return true
+	default:
+		return false
+	}
+}
+
+// markdownInline returns the Markdown for an inline node.
+func markdownInline(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+
+	switch n.Data {
+	case "strong", "b":
+		return markdownWrap(markdownInlineChildren(n), "**")
+	case "em", "i":
+		return markdownWrap(markdownInlineChildren(n), "_")
+	case "s":
+		return markdownWrap(markdownInlineChildren(n), "~~")
+	case "code":
+		return markdownWrap(htmlText(n), "`")
+	case "br":
+		return "\n"
+	case "a":
+		return "[" + strings.TrimSpace(markdownInlineChildren(n)) + "](" + htmlAttribute(n, "href") + ")"
+	case "img":
+		return "![" + htmlAttribute(n, "alt") + "](" + htmlAttribute(n, "src") + ")"
+	default:
+		return markdownInlineChildren(n)
+	}
+}
+
+func markdownInlineChildren(n *html.Node) string {
+	var b strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		b.WriteString(markdownInline(c))
+	}
+
+	return b.String()
+}
+
+// markdownWrap wraps the text with the marker, keeping surrounding whitespace outside the marker
+// since Markdown doesn't allow whitespace just inside the markers, e.g. "**bold **".
+func markdownWrap(text, marker string) string {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+
+	leading := text[:len(text)-len(strings.TrimLeftFunc(text, unicode.IsSpace))]
+	trailing := text[len(strings.TrimRightFunc(text, unicode.IsSpace)):]
+
+	return leading + marker + trimmed + marker + trailing
+}
+
+// htmlText returns the text of all text nodes below the given node.
+func htmlText(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+
+	var b strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		b.WriteString(htmlText(c))
+	}
+
+	return b.String()
+}
+
+// walkHTML calls fn for the given node and all nodes below it, in document order.
+func walkHTML(n *html.Node, fn func(n *html.Node)) {
+	fn(n)
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		walkHTML(c, fn)
+	}
+}
+
+// htmlAttribute returns the value of the attribute with the given key, or an empty string.
+func htmlAttribute(n *html.Node, key string) string {
+	for _, attr := range n.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+
+	return ""
+}
+
+// WordCount returns the number of words in the plain text of the rich text (see Text),
+// where a word is a sequence of non-space characters containing at least one letter or number.
+//
+// A nil or undefined RichText has no words.
+func (s RichText) WordCount() (int, error) {
+	if s.IsNil() {
+		return 0, nil
+	}
+
+	text, err := s.Text()
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, field := range strings.Fields(text) {
+		if strings.IndexFunc(field, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsNumber(r) }) >= 0 {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// CharCount returns the number of characters (runes) in the plain text of the rich text (see Text),
+// HTML entities are counted as the character they represent, so "&amp;" is one character.
+//
+// A nil or undefined RichText has no characters.
+func (s RichText) CharCount() (int, error) {
+	if s.IsNil() {
+		return 0, nil
+	}
+
+	text, err := s.Text()
+	if err != nil {
+		return 0, err
+	}
+
+	return utf8.RuneCountInString(text), nil
+}
+
+// Links returns the href of every link in the rich text, in document order.
+func (s RichText) Links() ([]string, error) {
+	links := []string{}
+
+	if s.IsNil() {
+		return links, nil
+	}
+
+	doc, err := html.Parse(strings.NewReader(s.underlying))
+	if err != nil {
+		return nil, err
+	}
+
+	walkHTML(doc, func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "a" {
+			if href := htmlAttribute(n, "href"); href != "" {
+				links = append(links, href)
+			}
+		}
+	})
+
+	return links, nil
+}
+
+// UniqueLinks returns the href of every link in the rich text in document order,
+// where links that occur multiple times are only returned the first time.
+func (s RichText) UniqueLinks() ([]string, error) {
+	links, err := s.Links()
+	if err != nil {
+		return nil, err
+	}
+
+	unique := []string{}
+	seen := map[string]bool{}
+
+	for _, link := range links {
+		if !seen[link] {
+			seen[link] = true
+			unique = append(unique, link)
+		}
+	}
+
+	return unique, nil
+}
+
+// ImageSources returns the src of every image in the rich text, in document order.
+func (s RichText) ImageSources() ([]string, error) {
+	sources := []string{}
+
+	if s.IsNil() {
+		return sources, nil
+	}
+
+	doc, err := html.Parse(strings.NewReader(s.underlying))
+	if err != nil {
+		return nil, err
+	}
+
+	walkHTML(doc, func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "img" {
+			if src := htmlAttribute(n, "src"); src != "" {
+				sources = append(sources, src)
+			}
+		}
+	})
+
+	return sources, nil
+}
+
+// RewriteImageSources returns a new RichText where the src of every image is replaced by the result of fn,
+// a nil RichText is returned as is.
+//
+// Note that the HTML is re-serialized, so the formatting of the content may change,
+// e.g. attributes will always be double quoted.
+func (s RichText) RewriteImageSources(fn func(src string) string) (RichText, error) {
+	if s.IsNil() {
+		return s, nil
+	}
+
+	body, err := parseHTMLFragment(s.underlying)
+	if err != nil {
+		return RichText{}, err
+	}
+
+	walkHTML(body, func(n *html.Node) {
+		if n.Type != html.ElementNode || n.Data != "img" {
+			return
+		}
+
+		for i := range n.Attr {
+			if n.Attr[i].Key == "src" {
+				n.Attr[i].Val = fn(n.Attr[i].Val)
+			}
+		}
+	})
+
+	s.underlying, err = renderHTMLChildren(body)
+	if err != nil {
+		return RichText{}, err
+	}
+
+	return s, nil
+}
+
+// CountElements returns the number of elements with the tag name (e.g. "img", "a" or "h1") in the rich text,
+// the tag name is case-insensitive. A nil RichText returns 0.
+func (s RichText) CountElements(tagName string) (int, error) {
+	if s.IsNil() {
+		return 0, nil
+	}
+
+	doc, err := html.Parse(strings.NewReader(s.underlying))
+	if err != nil {
+		return 0, err
+	}
+
+	tagName = strings.ToLower(tagName)
+	count := 0
+
+	walkHTML(doc, func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == tagName {
+			count++
+		}
+	})
+
+	return count, nil
+}
+
+// CountImages returns the number of images in the rich text, see CountElements.
+func (s RichText) CountImages() (int, error) {
+	return s.CountElements("img")
+}
+
+// ValidateMaxChars returns an error if the visible text is longer than maxChars characters,
+// which is the number of runes in the plain text returned by Text, not the HTML. A nil RichText is valid.
+func (s RichText) ValidateMaxChars(maxChars int) error {
+	if s.IsNil() {
+		return nil
+	}
+
+	text, err := s.Text()
+	if err != nil {
+		return err
+	}
+
+	length := utf8.RuneCountInString(strings.TrimSpace(text))
+	if length > maxChars {
+		return errors.New(fmt.Sprintf("text length %d exceeds the max length of %d characters", length, maxChars))
+	}
+
+	return nil
+}
+
+// Preview returns the text of the RichText on one line for e.g. list views, where the paragraphs and other whitespace
+// are collapsed into single spaces. If the text is longer than maxRunes characters it is cut at the last whole word
+// and ends with "…", the ellipsis is included in the maxRunes characters. A word longer than the limit is cut.
+// A nil or undefined RichText returns an empty string.
+func (s RichText) Preview(maxRunes int) (string, error) {
+	if s.IsNil() || maxRunes < 1 {
+		return "", nil
+	}
+
+	text, err := s.Text()
+	if err != nil {
+		return "", err
+	}
+
+	runes := []rune(strings.Join(strings.Fields(text), " "))
+	if len(runes) <= maxRunes {
+		return string(runes), nil
+	}
+
+	preview := string(runes[:maxRunes-1])
+
+	// Cut at the last space unless the limit is at the end of a word
+	if runes[maxRunes-1] != ' ' {
+		if i := strings.LastIndex(preview, " "); i >= 0 {
+			preview = preview[:i]
+		}
+	}
+
+	return strings.TrimRight(preview, " ") + "…", nil
+}
+
+// defaultRichTextAllowedTags contains the HTML tags that are kept when RichText is sanitized,
+// mapped to the attributes that are kept for each tag, see SetRichTextAllowedTags.
+var defaultRichTextAllowedTags = map[string][]string{
+	"a":      {"href", "target", "rel"},
+	"br":     {},
+	"code":   {},
+	"em":     {},
+	"h1":     {},
+	"h2":     {},
+	"h3":     {},
+	"img":    {"src", "alt"},
+	"li":     {},
+	"ol":     {},
+	"p":      {},
+	"pre":    {},
+	"s":      {},
+	"strong": {},
+	"u":      {},
+	"ul":     {},
+}
+
+// richTextAllowedTags contains the tags used when RichText is sanitized, see SetRichTextAllowedTags.
+var richTextAllowedTags = newSetting(cloneRichTextTags(defaultRichTextAllowedTags))
+
+// RichTextAllowedTags returns a copy of the HTML tags that are kept when RichText is sanitized,
+// mapped to the attributes that are kept for each tag, which can be extended and passed to SetRichTextAllowedTags.
+func RichTextAllowedTags() map[string][]string {
+	return cloneRichTextTags(richTextAllowedTags.get())
+}
+
+// SetRichTextAllowedTags sets the HTML tags that are kept when RichText is sanitized, mapped to the attributes
+// that are kept for each tag. Tags that are not allowed are removed, but their content is kept.
+// The tags are copied and a nil map restores the default tags.
+//
+// It is meant to be called once at startup, since it changes how all RichText values are sanitized,
+// but it is safe for concurrent use.
+func SetRichTextAllowedTags(tags map[string][]string) {
+	if tags == nil {
+		tags = defaultRichTextAllowedTags
+	}
+
+	richTextAllowedTags.set(cloneRichTextTags(tags))
+}
+
+// cloneRichTextTags returns a deep copy of the tags, so that the setting can't be modified by the caller.
+func cloneRichTextTags(tags map[string][]string) map[string][]string {
+	clone := make(map[string][]string, len(tags))
+	for tag, attributes := range tags {
+		clone[tag] = slices.Clone(attributes)
+	}
+
+	return clone
+}
+
+// richTextRemovedTags are removed together with their content when RichText is sanitized,
+// even if they are allowed with SetRichTextAllowedTags.
+var richTextRemovedTags = map[string]bool{
+	"embed":  true,
+	"iframe": true,
+	"object": true,
+	"script": true,
+	"style":  true,
+}
+
+// Sanitize returns a new RichText where dangerous HTML has been removed.
+//
+// Script, style and iframe tags are removed together with their content,
+// tags that are not in RichTextAllowedTags are removed while keeping their content,
+// attributes that are not allowed (such as event handlers) are removed,
+// links with unsafe URLs (such as "javascript:") are removed
+// and all links get rel="noopener".
+func (s RichText) Sanitize() (RichText, error) {
+	if s.IsNil() {
+		return s, nil
+	}
+
+	content, err := sanitizeHTML(s.underlying)
+	if err != nil {
+		return RichText{}, err
+	}
+
+	s.underlying = content
+
+	return s, nil
+}
+
+func sanitizeHTML(content string) (string, error) {
+	body, err := parseHTMLFragment(content)
+	if err != nil {
+		return "", err
+	}
+
+	sanitizeNode(body, richTextAllowedTags.get())
+
+	return renderHTMLChildren(body)
+}
+
+// parseHTMLFragment parses the content as the inner HTML of a body element,
+// which is returned with the parsed nodes as children.
+func parseHTMLFragment(content string) (*html.Node, error) {
+	body := &html.Node{
+		Type:     html.ElementNode,
+		Data:     "body",
+		DataAtom: atom.Body,
+	}
+
+	nodes, err := html.ParseFragment(strings.NewReader(content), body)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, n := range nodes {
+		body.AppendChild(n)
+	}
+
+	return body, nil
+}
+
+// renderHTMLChildren renders the children of the given node, i.e. the inner HTML.
+func renderHTMLChildren(n *html.Node) (string, error) {
+	var b bytes.Buffer
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if err := html.Render(&b, c); err != nil {
+			return "", err
+		}
+	}
+
+	return b.String(), nil
+}
+
+// sanitizeNode recursively sanitizes the children of the given node.
+func sanitizeNode(n *html.Node, allowedTags map[string][]string) {
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+
+		switch c.Type {
+		case html.TextNode:
+			// Text is escaped when rendered
+
+		case html.ElementNode:
+			if richTextRemovedTags[c.Data] {
+				n.RemoveChild(c)
+				break
+			}
+
+			sanitizeNode(c, allowedTags)
+
+			allowedAttributes, ok := allowedTags[c.Data]
+			if !ok {
+				// Keep the content of the tag by moving the children to the parent
+				for gc := c.FirstChild; gc != nil; gc = c.FirstChild {
+					c.RemoveChild(gc)
+					n.InsertBefore(gc, c)
+				}
+
+				n.RemoveChild(c)
+				break
+			}
+
+			c.Attr = sanitizeAttributes(c.Data, c.Attr, allowedAttributes)
+
+		default:
+			// Comments, doctypes etc. are removed
+			n.RemoveChild(c)
+		}
+
+		c = next
+	}
+}
+
+func sanitizeAttributes(tag string, attributes []html.Attribute, allowedAttributes []string) []html.Attribute {
+	sanitized := []html.Attribute{}
+	rel := ""
+
+	for _, attr := range attributes {
+		key := strings.ToLower(attr.Key)
+
+		if attr.Namespace != "" || strings.HasPrefix(key, "on") || !slices.Contains(allowedAttributes, key) {
+			continue
+		}
+
+		if (key == "href" || key == "src") && !isSafeURL(attr.Val) {
+			continue
+		}
+
+		if key == "rel" {
+			rel = attr.Val
+			continue
+		}
+
+		sanitized = append(sanitized, html.Attribute{Key: key, Val: attr.Val})
+	}
+
+	if tag == "a" {
+		if !slices.Contains(strings.Fields(rel), "noopener") {
+			rel = strings.TrimSpace("noopener " + rel)
+		}
+
+		sanitized = append(sanitized, html.Attribute{Key: "rel", Val: rel})
+	}
+
+	return sanitized
+}
+
+// isSafeURL returns true if the URL is relative or uses a scheme which can't execute scripts.
+func isSafeURL(rawURL string) bool {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return false
+	}
+
+	switch strings.ToLower(u.Scheme) {
+	case "", "http", "https", "mailto":
+		return true
+	default:
+		return false
+	}
+}
+
+// RichTextMode decides how RichText is marshaled to JSON, see SetRichTextMarshalMode.
+type RichTextMode int
+
+const (
+	// RichTextEnvelope marshals RichText as {"content": "<p>html</p>", "text": "html"}.
+	RichTextEnvelope RichTextMode = iota
+
+	// RichTextContentOnly marshals RichText as the HTML string "<p>html</p>".
+	RichTextContentOnly
+)
+
+// richTextMarshalMode is the mode used when marshaling RichText to JSON, see SetRichTextMarshalMode.
+var richTextMarshalMode = newSetting(RichTextEnvelope)
+
+// SetRichTextMarshalMode sets the mode used when marshaling RichText to JSON, the default is RichTextEnvelope.
+// Unmarshaling accepts both modes.
+//
+// It is meant to be called once at startup, since it changes the JSON of all RichText values,
+// but it is safe for concurrent use.
+func SetRichTextMarshalMode(mode RichTextMode) {
+	richTextMarshalMode.set(mode)
+}
+
+// MarshalJSON implements the json Marshaler interface.
+//
+// The content is marshaled as is, since it is sanitized when it enters the package through
+// RichTextFromString, Scan, UnmarshalJSON and UnmarshalYAML (see Sanitize).
+//
+// See: https://pkg.go.dev/encoding/json#Marshaler
+func (s RichText) MarshalJSON() ([]byte, error) {
+	if s.IsNil() {
+		return nullBytes, nil
+	}
+
+	if richTextMarshalMode.get() == RichTextContentOnly {
+		jsonBytes, err := json.Marshal(s.underlying)
+		if err != nil {
+			return nil, errors.Wrap(err, s.underlying)
+		}
+
+		return jsonBytes, nil
+	}
+
+	text, err := s.Text()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot convert to text "+s.underlying)
+	}
+
+	richText := struct {
+		Content string `json:"content"`
+		Text    string `json:"text"`
+	}{
+		Content: s.underlying,
+		Text:    text,
+	}
+
+	jsonBytes, err := json.Marshal(richText)
+	if err != nil {
+		return nil, errors.Wrap(err, s.underlying)
+	}
+
+	return jsonBytes, nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface,
+// the content is sanitized when it is unmarshaled (see Sanitize).
+//
+// See: https://pkg.go.dev/encoding/json#Unmarshaler
+func (s *RichText) UnmarshalJSON(d []byte) error {
+	s.isNil = isNullBytes(d)
+	s.isDefined = true
+
+	if s.isNil {
+		return nil
+	}
+
+	richText := struct {
+		Content string `json:"content"` // We only care about the content
+		Text    string `json:"-"`
+	}{}
+
+	// Both the envelope and a bare string are accepted regardless of the marshal mode,
+	// since third-party editors send the content as a plain JSON string
+	target := interface{}(&richText)
+	if bytes.HasPrefix(bytes.TrimSpace(d), []byte(`"`)) {
+		target = &richText.Content
+	}
+
+	err := json.Unmarshal(d, target)
+	if err != nil {
+		return err
+	}
+
+	s.underlying, err = sanitizeHTML(strings.TrimSpace(richText.Content))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalYAML implements the yaml Marshaler interface.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Marshaler
+func (s RichText) MarshalYAML() (interface{}, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+
+	text, err := s.Text()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot convert to text "+s.underlying)
+	}
+
+	return struct {
+		Content string `yaml:"content"`
+		Text    string `yaml:"text"`
+	}{
+		Content: s.underlying,
+		Text:    text,
+	}, nil
+}
+
+// UnmarshalYAML implements the yaml Unmarshaler interface,
+// the content is sanitized when it is unmarshaled (see Sanitize).
+//
+// Note that yaml.v3 does not call UnmarshalYAML for null values,
+// so a null in the YAML input leaves the value undefined.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Unmarshaler
+func (s *RichText) UnmarshalYAML(value *yaml.Node) error {
+	s.isNil = false
+	s.isDefined = true
+
+	richText := struct {
+		Content string `yaml:"content"` // We only care about the content
+	}{}
+
+	err := value.Decode(&richText)
+	if err != nil {
+		return err
+	}
+
+	s.underlying, err = sanitizeHTML(strings.TrimSpace(richText.Content))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary implements the encoding BinaryMarshaler interface,
+// the value is encoded as a state byte (undefined, nil or defined) followed by the value.
+//
+// See: https://pkg.go.dev/encoding#BinaryMarshaler
+func (s RichText) MarshalBinary() ([]byte, error) {
+	if s.IsNil() {
+		return marshalBinaryNil(s.isDefined), nil
+	}
+
+	return append([]byte{binaryDefined}, s.underlying...), nil
+}
+
+// UnmarshalBinary implements the encoding BinaryUnmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (s *RichText) UnmarshalBinary(d []byte) error {
+	isDefined, isNil, payload, err := unmarshalBinaryState(d)
+	if err != nil {
+		return err
+	}
+
+	var underlying string
+	if isDefined && !isNil {
+		underlying = string(payload)
+	}
+
+	*s = RichText{
+		underlying: underlying,
+		isDefined:  isDefined,
+		isNil:      isNil,
+	}
+
+	return nil
+}
+
+// EncodeMsgpack implements the msgpack CustomEncoder interface,
+// nil and undefined values are encoded as an extension type to keep the state.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomEncoder
+func (s RichText) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if s.IsNil() {
+		return encodeMsgpackNil(enc, s.isDefined)
+	}
+
+	return enc.EncodeString(s.underlying)
+}
+
+// DecodeMsgpack implements the msgpack CustomDecoder interface.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomDecoder
+func (s *RichText) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isDefined, isNil, err := decodeMsgpackState(dec)
+	if err != nil {
+		return err
+	}
+
+	if !isDefined || isNil {
+		*s = RichText{
+			isDefined: isDefined,
+			isNil:     isNil,
+		}
+
+		return nil
+	}
+
+	underlying, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+
+	*s = RichText{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}
+
+	return nil
+}
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface,
+// the content is sanitized when it is scanned (see Sanitize). Note that this means content
+// that was stored without being sanitized, or with other allowed tags, is changed when it is read.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (s *RichText) Scan(value interface{}) error {
+	s.isNil = (nil == value)
+	s.isDefined = true
+
+	if s.isNil {
+		return nil
+	}
+
+	err := convert.ConvertAssign(&s.underlying, value)
+	if err != nil {
+		return err
+	}
+
+	// Unsafe HTML may have been stored without going through this package
+	s.underlying, err = sanitizeHTML(s.underlying)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (s RichText) Value() (driver.Value, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+	return s.underlying, nil
+}
+
+// String is used to represent strings.
+type String struct {
+	underlying string
+	isDefined  bool
+	isNil      bool
+}
+
+// NewString creates a new String object.
+func NewString(underlying string) String {
+	return String{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}
+}
+
+// NewStringFromPtr creates a new String object from a pointer.
+func NewStringFromPtr(underlying *string) String {
+	if underlying != nil {
+		return NewString(*underlying)
+	}
+
+	return String{
+		isDefined: true,
+		isNil:     true,
+	}
+}
+
+// NewStringUndefined creates a new undefined String object.
+func NewStringUndefined() String {
+	return String{}
+}
+
+func StringFromStringPtr(strPtr *string) (String, error) {
+	if strPtr == nil {
+		return NewStringFromPtr(nil), nil
+	}
+
+	return StringFromString(*strPtr)
+}
+
+func StringFromString(str string) (String, error) {
+	if str == "" {
+		return NewStringFromPtr(nil), nil
+	}
+
+	var err error
+	underlying := strings.TrimSpace(str)
+
+	if err != nil {
+		return String{}, err
+	}
+
+	return String{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}, nil
+}
+
+// StringsFromStrings parses the strings with StringFromString,
+// an error is returned for the first string that cannot be parsed.
+func StringsFromStrings(strs []string) ([]String, error) {
+	return fromStrings(strs, StringFromString)
+}
+
+// StringsToStrings converts the values to strings with String, a nil value becomes an empty string.
+func StringsToStrings(values []String) []string {
+	return toStrings(values)
+}
+
+// String returns the string value.
+func (s String) String() string {
+	return s.underlying
+}
+
+// CSVValue returns the value for a CSV field, which is the same as String and an empty string for nil.
+func (s String) CSVValue() string {
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.String()
+}
+
+// StringPtr returns the string value as a pointer.
+func (s String) StringPtr() *string {
+	if s.IsNil() {
+		return nil
+	}
+	return &s.underlying
+}
+
+// IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
+func (s String) IsDefined() bool {
+	return s.isDefined
+}
+
+// IsNil returns true if the value is nil or undefined.
+func (s String) IsNil() bool {
+	// if the value is undefined, it is nil even though "isNil" will be set to false
+	if !s.isDefined {
+		return true
+	}
+
+	return s.isNil
+}
+
+// IsZero checks if String is nil, which is specifically used by sqlboiler queries
+func (s String) IsZero() bool { return s.IsNil() }
+
+// IsEmpty returns true if the String is nil, undefined or only contains whitespace,
+// unlike IsNil which returns false for "   ".
+func (s String) IsEmpty() bool {
+	return s.IsNil() || strings.TrimSpace(s.underlying) == ""
+}
+
+// Ptr returns the pointer for String, but returns nil if undefined.
+func (s String) Ptr() *String {
+	if !s.isDefined {
+		return nil
+	}
+
+	return &s
+}
+
+// Val returns the value of a String-pointer,
+// will return an undefined String if the pointer is nil.
+func (s *String) Val() String {
+	if s == nil {
+		return NewStringFromPtr(nil)
+	}
+
+	return *s
+}
+
+// Set sets the String to the value in place, which is the same as assigning NewString(v).
+func (s *String) Set(v string) {
+	*s = NewString(v)
+}
+
+// SetNil sets the String to nil in place, which is the same as assigning NewStringFromPtr(nil), so it is still defined.
+func (s *String) SetNil() {
+	*s = NewStringFromPtr(nil)
+}
+
+// SetUndefined sets the String to undefined in place, which is the same as assigning NewStringUndefined().
+func (s *String) SetUndefined() {
+	*s = NewStringUndefined()
+}
+
+// Validate validates the String against the rules, see Rule.
+func (s String) Validate(rules ...Rule) error {
+	return validate(s, rules)
+}
+
+// Compare implements the Ordered interface, nil values sort before all non-nil values
+// and strings are compared byte-wise, use CompareCollated for the order of a language.
+func (s String) Compare(other any) (int, error) {
+	return compareAny(s, other, func(a, b String) int { return strings.Compare(a.underlying, b.underlying) })
+}
+
+// Hash returns a hash of the state and the value, which can be used for change detection, see HashStruct.
+func (s String) Hash() uint64 {
+	return hashBinary(s)
+}
+
+// StringToLower returns the underlying value of String in lower case.
+func StringToLower(s String) String {
+	if !s.IsNil() {
+		s.underlying = strings.ToLower(s.underlying)
+	}
+
+	return s
+}
+
+// StringsToLower returns the underlying values of Strings in lower case.
+func StringsToLower(s []String) []String {
+	for i := range s {
+		s[i].underlying = strings.ToLower(s[i].underlying)
+	}
+
+	return s
+}
+
+// StringOrDefault returns the underlying value of String,
+// or the given default if the String is nil or undefined.
+func StringOrDefault(s String, def string) string {
+	if s.IsNil() {
+		return def
+	}
+
+	return s.underlying
+}
+
+// Len returns the number of characters (runes) in the String, or 0 if the String is nil.
+func (s String) Len() int {
+	if s.IsNil() {
+		return 0
+	}
+
+	return utf8.RuneCountInString(s.underlying)
+}
+
+// Truncate returns a new String with at most n characters (runes), so multibyte characters are never cut in half.
+// A nil or undefined String is returned as is.
+func (s String) Truncate(n int) String {
+	if s.IsNil() || s.Len() <= n {
+		return s
+	}
+
+	s.underlying = string([]rune(s.underlying)[:max(n, 0)])
+
+	return s
+}
+
+// TruncateWithEllipsis is like Truncate, but ends the String with "…" if it is truncated,
+// the ellipsis is included in the n characters.
+func (s String) TruncateWithEllipsis(n int) String {
+	if s.IsNil() || s.Len() <= n || n < 1 {
+		return s.Truncate(n)
+	}
+
+	s = s.Truncate(n - 1)
+	s.underlying += "…"
+
+	return s
+}
+
+// CompareCollated compares the String with another String using the collation of the given language,
+// it returns -1 if s sorts before other, 1 if s sorts after other and 0 if they are equal.
+//
+// Nil values sort before all non-nil values.
+func (s String) CompareCollated(other String, tag language.Tag) int {
+	return compareCollated(collate.New(tag), s, other)
+}
+
+// Matches returns true if the String matches the regular expression, e.g. a pattern for course codes.
+// A nil String never matches.
+func (s String) Matches(re *regexp.Regexp) bool {
+	if s.IsNil() {
+		return false
+	}
+
+	return re.MatchString(s.underlying)
+}
+
+// Normalize returns a new String in the Unicode normalization form, e.g. norm.NFC,
+// so that visually identical strings with different representations of combining accents are equal.
+// A nil or undefined String is returned as is.
+func (s String) Normalize(form norm.Form) String {
+	if s.IsNil() {
+		return s
+	}
+
+	return NewString(form.String(s.underlying))
+}
+
+// NormalizeWith returns a new String where all matches of the regular expression are replaced with repl,
+// see regexp.Regexp.ReplaceAllString. A nil or undefined String is returned as is.
+func (s String) NormalizeWith(re *regexp.Regexp, repl string) String {
+	if s.IsNil() {
+		return s
+	}
+
+	return NewString(re.ReplaceAllString(s.underlying, repl))
+}
+
+// slugDisallowed matches the characters that are not allowed in a slug.
+var slugDisallowed = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Slugify returns a new String which is a URL-safe slug, e.g. "Årskurs 9 – Örebro" becomes "arskurs-9-orebro".
+//
+// Accents are removed (å, ä and ö become a, a and o), the slug is lowercased
+// and all other characters are replaced with hyphens. A nil or undefined String is returned as is.
+func (s String) Slugify() String {
+	if s.IsNil() {
+		return s
+	}
+
+	// Decomposing separates the accents from the letters, so that the accents can be removed
+	var b strings.Builder
+	for _, r := range norm.NFD.String(strings.ToLower(s.underlying)) {
+		if !unicode.Is(unicode.Mn, r) {
+			b.WriteRune(r)
+		}
+	}
+
+	return NewString(strings.Trim(slugDisallowed.ReplaceAllString(b.String(), "-"), "-"))
+}
+
+// LooksNumeric returns true if the String only consists of the digits 0-9, e.g. "007".
+//
+// Such values are often identifiers (postal codes, student numbers) rather than numbers,
+// keep them as String since parsing them into Int drops the leading zeros,
+// or use PadLeft to restore a fixed width on output. A nil or empty String is not numeric.
+func (s String) LooksNumeric() bool {
+	if s.IsNil() || s.underlying == "" {
+		return false
+	}
+
+	for _, r := range s.underlying {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PadLeft returns a new String padded with pad on the left to at least width characters (runes),
+// e.g. NewString("7").PadLeft(3, '0') is "007". A nil or undefined String is returned as is.
+func (s String) PadLeft(width int, pad rune) String {
+	if s.IsNil() || s.Len() >= width {
+		return s
+	}
+
+	return NewString(strings.Repeat(string(pad), width-s.Len()) + s.underlying)
+}
+
+// Canonical returns a new String in a canonical form for e.g. case-insensitive unique indexes,
+// it is lowercased, normalized to NFC and the whitespace is collapsed to single spaces and trimmed,
+// so "Åsa  Öberg" with a decomposed "Å" and "åsa öberg" have the same canonical form.
+// A nil or undefined String is returned as is.
+func (s String) Canonical() String {
+	if s.IsNil() {
+		return s
+	}
+
+	return NewString(strings.Join(strings.Fields(norm.NFC.String(strings.ToLower(s.underlying))), " "))
+}
+
+// CollateStrings sorts the Strings in place using the collation of the given language,
+// for example "ä" sorts after "z" in Swedish.
+//
+// Nil values sort before all non-nil values.
+func CollateStrings(s []String, tag language.Tag) {
+	c := collate.New(tag)
+
+	sort.SliceStable(s, func(i, j int) bool {
+		return compareCollated(c, s[i], s[j]) < 0
+	})
+}
+
+func compareCollated(c *collate.Collator, a, b String) int {
+	switch {
+	case a.IsNil() && b.IsNil():
+		return 0
+	case a.IsNil():
+		return -1
+	case b.IsNil():
+		return 1
+	}
+
+	return c.CompareString(a.underlying, b.underlying)
+}
+
+// MarshalJSON implements the json Marshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Marshaler
+func (s String) MarshalJSON() ([]byte, error) {
+	if s.IsNil() {
+		return nullBytes, nil
+	}
+
+	jsonBytes, err := json.Marshal(s.underlying)
+	if err != nil {
+		return nil, errors.Wrap(err, s.String())
+	}
+
+	return jsonBytes, nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Unmarshaler
+func (s *String) UnmarshalJSON(d []byte) error {
+	s.isNil = isNullBytes(d)
+	s.isDefined = true
+
+	if s.isNil {
+		return nil
+	}
+
+	err := json.Unmarshal(d, &s.underlying)
+	if err != nil {
+		return err
+	}
+
+	s.underlying = strings.TrimSpace(s.underlying)
+
+	return nil
+}
+
+// MarshalYAML implements the yaml Marshaler interface.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Marshaler
+func (s String) MarshalYAML() (interface{}, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+
+	return s.underlying, nil
+}
+
+// UnmarshalYAML implements the yaml Unmarshaler interface.
+//
+// Note that yaml.v3 does not call UnmarshalYAML for null values,
+// so a null in the YAML input leaves the value undefined.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Unmarshaler
+func (s *String) UnmarshalYAML(value *yaml.Node) error {
+	s.isNil = false
+	s.isDefined = true
+
+	err := value.Decode(&s.underlying)
+	if err != nil {
+		return err
+	}
+
+	s.underlying = strings.TrimSpace(s.underlying)
+
+	return nil
+}
+
+// MarshalBinary implements the encoding BinaryMarshaler interface,
+// the value is encoded as a state byte (undefined, nil or defined) followed by the value.
+//
+// See: https://pkg.go.dev/encoding#BinaryMarshaler
+func (s String) MarshalBinary() ([]byte, error) {
+	if s.IsNil() {
+		return marshalBinaryNil(s.isDefined), nil
+	}
+
+	return append([]byte{binaryDefined}, s.underlying...), nil
+}
+
+// UnmarshalBinary implements the encoding BinaryUnmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (s *String) UnmarshalBinary(d []byte) error {
+	isDefined, isNil, payload, err := unmarshalBinaryState(d)
+	if err != nil {
+		return err
+	}
+
+	var underlying string
+	if isDefined && !isNil {
+		underlying = string(payload)
+	}
+
+	*s = String{
+		underlying: underlying,
+		isDefined:  isDefined,
+		isNil:      isNil,
+	}
+
+	return nil
+}
+
+// EncodeMsgpack implements the msgpack CustomEncoder interface,
+// nil and undefined values are encoded as an extension type to keep the state.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomEncoder
+func (s String) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if s.IsNil() {
+		return encodeMsgpackNil(enc, s.isDefined)
+	}
+
+	return enc.EncodeString(s.underlying)
+}
+
+// DecodeMsgpack implements the msgpack CustomDecoder interface.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomDecoder
+func (s *String) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isDefined, isNil, err := decodeMsgpackState(dec)
+	if err != nil {
+		return err
+	}
+
+	if !isDefined || isNil {
+		*s = String{
+			isDefined: isDefined,
+			isNil:     isNil,
+		}
+
+		return nil
+	}
+
+	underlying, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+
+	*s = String{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}
+
+	return nil
+}
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (s *String) Scan(value interface{}) error {
+	s.isNil = (nil == value)
+	s.isDefined = true
+
+	if s.isNil {
+		s.underlying = ""
+		return nil
+	}
+
+	return convert.ConvertAssign(&s.underlying, value)
+}
+
+// Value implements the driver Valuer interface.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (s String) Value() (driver.Value, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+	return s.underlying, nil
+}
+
+// StringEnumSet is the set of allowed values of a StringEnum, which is created with NewStringEnum.
+type StringEnumSet struct {
+	allowed         []string
+	caseInsensitive bool
+}
+
+// NewStringEnum creates a case-sensitive set of allowed values, e.g. NewStringEnum("active", "archived", "pending"),
+// see CaseInsensitive and StringEnum.
+func NewStringEnum(allowed ...string) *StringEnumSet {
+	return &StringEnumSet{
+		allowed: slices.Clone(allowed),
+	}
+}
+
+// CaseInsensitive returns a copy of the set where values are matched case-insensitively,
+// a matched value is stored with the spelling of the allowed value, e.g. "Active" becomes "active".
+func (e *StringEnumSet) CaseInsensitive() *StringEnumSet {
+	return &StringEnumSet{
+		allowed:         e.allowed,
+		caseInsensitive: true,
+	}
+}
+
+// Allowed returns the allowed values.
+func (e *StringEnumSet) Allowed() []string {
+	return slices.Clone(e.allowed)
+}
+
+// match returns the allowed value matching the string, or an error if the value is not allowed.
+func (e *StringEnumSet) match(str string) (string, error) {
+	for _, allowed := range e.allowed {
+		if allowed == str || (e.caseInsensitive && strings.EqualFold(allowed, str)) {
+			return allowed, nil
+		}
+	}
+
+	return "", errors.New(fmt.Sprintf("invalid value %q, must be one of: %s", str, strings.Join(e.allowed, ", ")))
+}
+
+// FromString parses the string like StringFromString,
+// but returns an error if the value is not allowed. An empty string is parsed as nil.
+func (e *StringEnumSet) FromString(str string) (String, error) {
+	s, err := StringFromString(str)
+	if err != nil || s.IsNil() {
+		return s, err
+	}
+
+	s.underlying, err = e.match(s.underlying)
+	if err != nil {
+		return String{}, err
+	}
+
+	return s, nil
+}
+
+// StringEnumDefinition defines the allowed values of a StringEnum, e.g:
+//
+//	var statuses = types.NewStringEnum("active", "archived", "pending")
+//
+//	type Status struct{}
+//
+//	func (Status) StringEnum() *types.StringEnumSet { return statuses }
+type StringEnumDefinition interface {
+	StringEnum() *StringEnumSet
+}
+
+// StringEnum is a String which only accepts the values allowed by the definition E,
+// e.g. StringEnum[Status] where Status implements StringEnumDefinition.
+//
+// The values are checked when parsed, unmarshaled or scanned, nil and undefined values are preserved like String.
+// All other methods are the ones of String, so a StringEnum can replace a String in a struct.
+type StringEnum[E StringEnumDefinition] struct {
+	enumString
+}
+
+// enumString is embedded by StringEnum, the alias makes sure that the field is not named String
+// since it would hide the String method.
+type enumString = String
+
+// NewStringEnumUndefined creates a new undefined StringEnum object.
+func NewStringEnumUndefined[E StringEnumDefinition]() StringEnum[E] {
+	return StringEnum[E]{}
+}
+
+// StringEnumFromString parses the string with the set of E, see StringEnumSet.FromString.
+func StringEnumFromString[E StringEnumDefinition](str string) (StringEnum[E], error) {
+	var definition E
+
+	s, err := definition.StringEnum().FromString(str)
+	if err != nil {
+		return StringEnum[E]{}, err
+	}
+
+	return StringEnum[E]{enumString: s}, nil
+}
+
+// check makes sure the value is allowed by the definition, an invalid value is reset to undefined.
+func (s *StringEnum[E]) check() error {
+	if s.IsNil() {
+		return nil
+	}
+
+	var definition E
+
+	underlying, err := definition.StringEnum().match(s.underlying)
+	if err != nil {
+		s.enumString = String{}
+		return err
+	}
+
+	s.underlying = underlying
+
+	return nil
+}
+
+// Set sets the StringEnum to the value in place if it is allowed by the definition E,
+// otherwise an error is returned and the StringEnum is left unchanged. SetNil and SetUndefined are the ones of String.
+func (s *StringEnum[E]) Set(v string) error {
+	value := StringEnum[E]{enumString: NewString(v)}
+
+	err := value.check()
+	if err != nil {
+		return err
+	}
+
+	*s = value
+
+	return nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Unmarshaler
+func (s *StringEnum[E]) UnmarshalJSON(d []byte) error {
+	err := s.enumString.UnmarshalJSON(d)
+	if err != nil {
+		return err
+	}
+
+	return s.check()
+}
+
+// UnmarshalYAML implements the yaml Unmarshaler interface.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Unmarshaler
+func (s *StringEnum[E]) UnmarshalYAML(value *yaml.Node) error {
+	err := s.enumString.UnmarshalYAML(value)
+	if err != nil {
+		return err
+	}
+
+	return s.check()
+}
+
+// UnmarshalBinary implements the encoding BinaryUnmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (s *StringEnum[E]) UnmarshalBinary(d []byte) error {
+	err := s.enumString.UnmarshalBinary(d)
+	if err != nil {
+		return err
+	}
+
+	return s.check()
+}
+
+// DecodeMsgpack implements the msgpack CustomDecoder interface.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomDecoder
+func (s *StringEnum[E]) DecodeMsgpack(dec *msgpack.Decoder) error {
+	err := s.enumString.DecodeMsgpack(dec)
+	if err != nil {
+		return err
+	}
+
+	return s.check()
+}
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (s *StringEnum[E]) Scan(value interface{}) error {
+	err := s.enumString.Scan(value)
+	if err != nil {
+		return err
+	}
+
+	return s.check()
+}
+
+// Time is used to represent a times by the format "HH:MM"
+type Time struct {
+	underlying time.Time
+	isDefined  bool
+	isNil      bool
+}
+
+// NewTime creates a new Time object.
+func NewTime(underlying time.Time) Time {
+	return Time{
+		underlying: underlyingTime(underlying, "15:04"),
+		isDefined:  true,
+		isNil:      false,
+	}
+}
+
+// NewTimeFromPtr creates a new Time object from a pointer.
+func NewTimeFromPtr(underlying *time.Time) Time {
+	if underlying != nil {
+		return NewTime(*underlying)
+	}
+
+	return Time{
+		isDefined: true,
+		isNil:     true,
+	}
+}
+
+// NewTimeUndefined creates a new undefined Time object.
+func NewTimeUndefined() Time {
+	return Time{}
+}
+
+// NewTimeFromDuration creates a new Time object from the duration since midnight, e.g. 14*time.Hour + 30*time.Minute is 14:30.
+// Durations outside of a day wrap around, so 25 hours is 01:00 and -30 minutes is 23:30, and seconds are truncated.
+func NewTimeFromDuration(d time.Duration) Time {
+	d %= 24 * time.Hour
+	if d < 0 {
+		d += 24 * time.Hour
+	}
+
+	return NewTime(time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(d))
+}
+
+func TimeFromStringPtr(strPtr *string) (Time, error) {
+	if strPtr == nil {
+		return NewTimeFromPtr(nil), nil
+	}
+
+	return TimeFromString(*strPtr)
+}
+
+func TimeFromString(str string) (Time, error) {
+	if str == "" {
+		return NewTimeFromPtr(nil), nil
+	}
+
+	underlying, err := time.Parse("15:04", strings.TrimSpace(str))
+	if err != nil {
+		return Time{}, err
+	}
+
+	return Time{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}, nil
+}
+
+// MustTimeFromString is like TimeFromString but panics if the string cannot be parsed, like uuid.MustParse.
+// It is meant for tests and package-level variables, e.g. MustTimeFromString("15:04"), never for untrusted input.
+func MustTimeFromString(str string) Time {
+	return must(TimeFromString(str))
+}
+
+// String output Time
+func (s Time) String() string {
+	// If the value is nil we return an empty string
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.underlying.Format("15:04")
+}
+
+// CSVValue returns the value for a CSV field, which is the same as String and an empty string for nil.
+func (s Time) CSVValue() string {
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.String()
+}
+
+// Time returns the time.Time value.
+func (s Time) Time() time.Time {
+	return s.underlying
+}
+
+// SinceMidnight returns the duration since 00:00, e.g. 14:30 is 14*time.Hour + 30*time.Minute,
+// which is useful for scheduling math. A nil Time returns 0.
+func (s Time) SinceMidnight() time.Duration {
+	if s.IsNil() {
+		return 0
+	}
+
+	return time.Duration(s.underlying.Hour())*time.Hour + time.Duration(s.underlying.Minute())*time.Minute
+}
+
+// Before returns true if the time is before the other time, only the hour and minute are compared.
+// If any of the times is nil, false is returned.
+func (s Time) Before(other Time) bool {
+	if s.IsNil() || other.IsNil() {
+		return false
+	}
+
+	return s.SinceMidnight() < other.SinceMidnight()
+}
+
+// After returns true if the time is after the other time, only the hour and minute are compared.
+// If any of the times is nil, false is returned.
+func (s Time) After(other Time) bool {
+	if s.IsNil() || other.IsNil() {
+		return false
+	}
+
+	return s.SinceMidnight() > other.SinceMidnight()
+}
+
+// Equal returns true if both times have the same hour and minute, or if both times are nil.
+func (s Time) Equal(other Time) bool {
+	if s.IsNil() || other.IsNil() {
+		return s.IsNil() && other.IsNil()
+	}
+
+	return s.SinceMidnight() == other.SinceMidnight()
+}
+
+// TimePtr returns the time.Time value as a pointer.
+func (s Time) TimePtr() *time.Time {
+	if s.IsNil() {
+		return nil
+	}
+	return &s.underlying
+}
+
+// IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
+func (s Time) IsDefined() bool {
+	return s.isDefined
+}
+
+// IsNil returns true if the value is nil or undefined.
+func (s Time) IsNil() bool {
+	// if the value is undefined, it is nil even though "isNil" will be set to false
+	if !s.isDefined {
+		return true
+	}
+
+	return s.isNil
+}
+
+// IsZero checks if Time is nil, which is specifically used by sqlboiler queries
+func (s Time) IsZero() bool { return s.IsNil() }
+
+// Ptr returns the pointer for Time, but returns nil if undefined.
+func (s Time) Ptr() *Time {
+	if !s.isDefined {
+		return nil
+	}
+
+	return &s
+}
+
+// Val returns the value of a Time-pointer,
+// will return an undefined Time if the pointer is nil.
+func (s *Time) Val() Time {
+	if s == nil {
+		return NewTimeFromPtr(nil)
+	}
+
+	return *s
+}
+
+// Set sets the Time to the value in place, which is the same as assigning NewTime(v).
+func (s *Time) Set(v time.Time) {
+	*s = NewTime(v)
+}
+
+// SetNil sets the Time to nil in place, which is the same as assigning NewTimeFromPtr(nil), so it is still defined.
+func (s *Time) SetNil() {
+	*s = NewTimeFromPtr(nil)
+}
+
+// SetUndefined sets the Time to undefined in place, which is the same as assigning NewTimeUndefined().
+func (s *Time) SetUndefined() {
+	*s = NewTimeUndefined()
+}
+
+// Validate validates the Time against the rules, see Rule.
+func (s Time) Validate(rules ...Rule) error {
+	return validate(s, rules)
+}
+
+// Compare implements the Ordered interface, nil values sort before all non-nil values.
+func (s Time) Compare(other any) (int, error) {
+	return compareAny(s, other, func(a, b Time) int {
+		return underlyingTime(a.underlying, "15:04").Compare(underlyingTime(b.underlying, "15:04"))
+	})
+}
+
+// Hash returns a hash of the state and the value, which can be used for change detection, see HashStruct.
+func (s Time) Hash() uint64 {
+	return hashBinary(s)
+}
+
+// MarshalJSON implements the json Marshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Marshaler
+func (s Time) MarshalJSON() ([]byte, error) {
+	if s.IsNil() {
+		return nullBytes, nil
+	}
+
+	return quoteTime(s.underlying, "15:04"), nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Unmarshaler
+func (s *Time) UnmarshalJSON(d []byte) error {
+	s.isNil = isNullBytes(d)
+	s.isDefined = true
+
+	if s.isNil {
+		return nil
+	}
+
+	var str string
+	err := json.Unmarshal(d, &str)
+	if err != nil {
+		return err
+	}
+
+	s.underlying, err = time.Parse("15:04", str)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalYAML implements the yaml Marshaler interface.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Marshaler
+func (s Time) MarshalYAML() (interface{}, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+
+	return s.underlying.Format("15:04"), nil
+}
+
+// UnmarshalYAML implements the yaml Unmarshaler interface.
+//
+// Note that yaml.v3 does not call UnmarshalYAML for null values,
+// so a null in the YAML input leaves the value undefined.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Unmarshaler
+func (s *Time) UnmarshalYAML(value *yaml.Node) error {
+	s.isNil = false
+	s.isDefined = true
+
+	var str string
+	err := value.Decode(&str)
+	if err != nil {
+		return err
+	}
+
+	s.underlying, err = time.Parse("15:04", str)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary implements the encoding BinaryMarshaler interface,
+// the value is encoded as a state byte (undefined, nil or defined) followed by the value.
+//
+// See: https://pkg.go.dev/encoding#BinaryMarshaler
+func (s Time) MarshalBinary() ([]byte, error) {
+	if s.IsNil() {
+		return marshalBinaryNil(s.isDefined), nil
+	}
+
+	payload, err := s.underlying.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{binaryDefined}, payload...), nil
+}
+
+// UnmarshalBinary implements the encoding BinaryUnmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (s *Time) UnmarshalBinary(d []byte) error {
+	isDefined, isNil, payload, err := unmarshalBinaryState(d)
+	if err != nil {
+		return err
+	}
+
+	var underlying time.Time
+	if isDefined && !isNil {
+		err := underlying.UnmarshalBinary(payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	*s = Time{
+		underlying: underlying,
+		isDefined:  isDefined,
+		isNil:      isNil,
+	}
+
+	return nil
+}
+
+// EncodeMsgpack implements the msgpack CustomEncoder interface,
+// nil and undefined values are encoded as an extension type to keep the state.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomEncoder
+func (s Time) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if s.IsNil() {
+		return encodeMsgpackNil(enc, s.isDefined)
+	}
+
+	return enc.EncodeString(s.String())
+}
+
+// DecodeMsgpack implements the msgpack CustomDecoder interface.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomDecoder
+func (s *Time) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isDefined, isNil, err := decodeMsgpackState(dec)
+	if err != nil {
+		return err
+	}
+
+	if !isDefined || isNil {
+		*s = Time{
+			isDefined: isDefined,
+			isNil:     isNil,
+		}
+
+		return nil
+	}
+
+	str, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+
+	*s, err = TimeFromString(str)
+	return err
+}
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (s *Time) Scan(value interface{}) error {
+	s.isNil = (nil == value)
+	s.isDefined = true
+
+	if s.isNil {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case time.Duration:
+		// A duration is the time since midnight
+		if v < 0 || v >= 24*time.Hour {
+			return errors.New(fmt.Sprintf("duration out of range for time: %s", v))
+		}
+
+		s.underlying = time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(v)
+		return nil
+
+	case time.Time:
+		// Only the clock is kept when scanning from e.g. a timestamp column
+		s.underlying = time.Date(0, 1, 1, v.Hour(), v.Minute(), v.Second(), v.Nanosecond(), time.UTC)
+		return nil
+
+	case []byte:
+		value = string(v)
+	}
+
+	val, ok := value.(string)
+	if ok {
+		// Postgres returns "15:04:05" for time and "15:04:05+00" for timetz,
+		// the wall clock is kept for values with a zone.
+		for _, layout := range []string{"15:04:05", "15:04:05Z07", "15:04:05Z07:00"} {
+			t, err := time.Parse(layout, val)
+			if err == nil {
+				s.underlying = time.Date(0, 1, 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+				return nil
+			}
+		}
+	}
+	return convert.ConvertAssign(&s.underlying, value)
+}
+
+// Value implements the driver Valuer interface.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (s Time) Value() (driver.Value, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+	return s.underlying, nil
+}
+
+// Scan time-of-day from Postgres
+func (s *Time) ScanTime(v pgtype.Time) error {
+	s.isNil = !v.Valid
+	s.isDefined = true
+
+	if s.isNil {
+		s.underlying = time.Time{}
+		return nil
+	}
+
+	// pgtype.Time is microseconds since midnight (in pgx v5).
+	// Convert to a stable time.Time anchored to year 0 (0000-01-01) in UTC,
+	// matching the anchor date used by time.Parse when no date is specified.
+	us := v.Microseconds
+	hours := us / (3600 * 1_000_000)
+	us %= 3600 * 1_000_000
+	minutes := us / (60 * 1_000_000)
+	us %= 60 * 1_000_000
+	seconds := us / 1_000_000
+	micros := us % 1_000_000
+
+	s.underlying = time.Date(0, 1, 1, int(hours), int(minutes), int(seconds), int(micros*1000), time.UTC)
+
+	return nil
+}
+
+// Encode time-of-day to Postgres
+func (s Time) TimeValue() (pgtype.Time, error) {
+	if s.IsNil() {
+		return pgtype.Time{
+			Microseconds: 0,
+			Valid:        false,
+		}, nil
+	}
+
+	// Extract time-of-day from your underlying time.Time
+	h, m, sec := s.underlying.Clock()
+	ns := s.underlying.Nanosecond()
+	us := int64(((h*60+m)*60+sec)*1_000_000) + int64(ns/1000)
+
+	return pgtype.Time{
+		Microseconds: us,
+		Valid:        true,
+	}, nil
+}
+
+// TimeSeconds is used to represent times by the format "HH:MM:SS",
+// use it instead of Time for database columns where the seconds must be kept.
+type TimeSeconds struct {
+	underlying time.Time
+	isDefined  bool
+	isNil      bool
+}
+
+// NewTimeSeconds creates a new TimeSeconds object.
+func NewTimeSeconds(underlying time.Time) TimeSeconds {
+	return TimeSeconds{
+		underlying: underlyingTime(underlying, "15:04:05"),
+		isDefined:  true,
+		isNil:      false,
+	}
+}
+
+// NewTimeSecondsFromPtr creates a new TimeSeconds object from a pointer.
+func NewTimeSecondsFromPtr(underlying *time.Time) TimeSeconds {
+	if underlying != nil {
+		return NewTimeSeconds(*underlying)
+	}
+
+	return TimeSeconds{
+		isDefined: true,
+		isNil:     true,
+	}
+}
+
+// NewTimeSecondsUndefined creates a new undefined TimeSeconds object.
+func NewTimeSecondsUndefined() TimeSeconds {
+	return TimeSeconds{}
+}
+
+func TimeSecondsFromStringPtr(strPtr *string) (TimeSeconds, error) {
+	if strPtr == nil {
+		return NewTimeSecondsFromPtr(nil), nil
+	}
+
+	return TimeSecondsFromString(*strPtr)
+}
+
+func TimeSecondsFromString(str string) (TimeSeconds, error) {
+	if str == "" {
+		return NewTimeSecondsFromPtr(nil), nil
+	}
+
+	underlying, err := time.Parse("15:04:05", strings.TrimSpace(str))
+	if err != nil {
+		return TimeSeconds{}, err
+	}
+
+	return TimeSeconds{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}, nil
+}
+
+// MustTimeSecondsFromString is like TimeSecondsFromString but panics if the string cannot be parsed, like uuid.MustParse.
+// It is meant for tests and package-level variables, e.g. MustTimeSecondsFromString("15:04:05"), never for untrusted input.
+func MustTimeSecondsFromString(str string) TimeSeconds {
+	return must(TimeSecondsFromString(str))
+}
+
+// DurationFromClockString parses an elapsed time stored as "HH:MM:SS" text, e.g. "01:30:45",
+// where the hours may exceed 24, e.g. "30:00:00". The minutes and seconds must be two digits between 00 and 59,
+// and an error is returned if the duration does not fit in a time.Duration.
+func DurationFromClockString(str string) (time.Duration, error) {
+	parts := strings.Split(strings.TrimSpace(str), ":")
+	if len(parts) != 3 || len(parts[1]) != 2 || len(parts[2]) != 2 {
+		return 0, errors.New("invalid clock duration format, expected HH:MM:SS: " + str)
+	}
+
+	values := make([]int, len(parts))
+	for i, part := range parts {
+		if part == "" || strings.Trim(part, "0123456789") != "" {
+			return 0, errors.New("invalid clock duration format, expected HH:MM:SS: " + str)
+		}
+
+		value, err := strconv.Atoi(part)
+		if err != nil {
+			return 0, errors.Wrap(err, "invalid clock duration: "+str)
+		}
+
+		values[i] = value
+	}
+
+	if values[1] > 59 || values[2] > 59 {
+		return 0, errors.New("invalid clock duration, minutes and seconds must be less than 60: " + str)
+	}
+
+	minutesAndSeconds := time.Duration(values[1])*time.Minute + time.Duration(values[2])*time.Second
+
+	// The largest time.Duration is about 2562047 hours
+	if values[0] > int((math.MaxInt64-minutesAndSeconds)/time.Hour) {
+		return 0, errors.New("invalid clock duration, the hours are too large: " + str)
+	}
+
+	return time.Duration(values[0])*time.Hour + minutesAndSeconds, nil
+}
+
+// String output TimeSeconds
+func (s TimeSeconds) String() string {
+	// If the value is nil we return an empty string
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.underlying.Format("15:04:05")
+}
+
+// CSVValue returns the value for a CSV field, which is the same as String and an empty string for nil.
+func (s TimeSeconds) CSVValue() string {
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.String()
+}
+
+// TimeSeconds returns the time.Time value.
+func (s TimeSeconds) TimeSeconds() time.Time {
+	return s.underlying
+}
+
+// TimeSecondsPtr returns the time.Time value as a pointer.
+func (s TimeSeconds) TimeSecondsPtr() *time.Time {
+	if s.IsNil() {
+		return nil
+	}
+	return &s.underlying
+}
+
+// IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
+func (s TimeSeconds) IsDefined() bool {
+	return s.isDefined
+}
+
+// IsNil returns true if the value is nil or undefined.
+func (s TimeSeconds) IsNil() bool {
+	// if the value is undefined, it is nil even though "isNil" will be set to false
+	if !s.isDefined {
+		return true
+	}
+
+	return s.isNil
+}
+
+// IsZero checks if TimeSeconds is nil, which is specifically used by sqlboiler queries
+func (s TimeSeconds) IsZero() bool { return s.IsNil() }
+
+// Ptr returns the pointer for TimeSeconds, but returns nil if undefined.
+func (s TimeSeconds) Ptr() *TimeSeconds {
+	if !s.isDefined {
+		return nil
+	}
+
+	return &s
+}
+
+// Val returns the value of a TimeSeconds-pointer,
+// will return an undefined TimeSeconds if the pointer is nil.
+func (s *TimeSeconds) Val() TimeSeconds {
+	if s == nil {
+		return NewTimeSecondsFromPtr(nil)
+	}
+
+	return *s
+}
+
+// Set sets the TimeSeconds to the value in place, which is the same as assigning NewTimeSeconds(v).
+func (s *TimeSeconds) Set(v time.Time) {
+	*s = NewTimeSeconds(v)
+}
+
+// SetNil sets the TimeSeconds to nil in place, which is the same as assigning NewTimeSecondsFromPtr(nil), so it is still defined.
+func (s *TimeSeconds) SetNil() {
+	*s = NewTimeSecondsFromPtr(nil)
+}
+
+// SetUndefined sets the TimeSeconds to undefined in place, which is the same as assigning NewTimeSecondsUndefined().
+func (s *TimeSeconds) SetUndefined() {
+	*s = NewTimeSecondsUndefined()
+}
+
+// Validate validates the TimeSeconds against the rules, see Rule.
+func (s TimeSeconds) Validate(rules ...Rule) error {
+	return validate(s, rules)
+}
+
+// Compare implements the Ordered interface, nil values sort before all non-nil values.
+func (s TimeSeconds) Compare(other any) (int, error) {
+	return compareAny(s, other, func(a, b TimeSeconds) int {
+		return underlyingTime(a.underlying, "15:04:05").Compare(underlyingTime(b.underlying, "15:04:05"))
+	})
+}
+
+// Hash returns a hash of the state and the value, which can be used for change detection, see HashStruct.
+func (s TimeSeconds) Hash() uint64 {
+	return hashBinary(s)
+}
+
+// MarshalJSON implements the json Marshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Marshaler
+func (s TimeSeconds) MarshalJSON() ([]byte, error) {
+	if s.IsNil() {
+		return nullBytes, nil
+	}
+
+	return quoteTime(s.underlying, "15:04:05"), nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Unmarshaler
+func (s *TimeSeconds) UnmarshalJSON(d []byte) error {
+	s.isNil = isNullBytes(d)
+	s.isDefined = true
+
+	if s.isNil {
+		return nil
+	}
+
+	var str string
+	err := json.Unmarshal(d, &str)
+	if err != nil {
+		return err
+	}
+
+	s.underlying, err = time.Parse("15:04:05", str)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalYAML implements the yaml Marshaler interface.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Marshaler
+func (s TimeSeconds) MarshalYAML() (interface{}, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+
+	return s.underlying.Format("15:04:05"), nil
+}
+
+// UnmarshalYAML implements the yaml Unmarshaler interface.
+//
+// Note that yaml.v3 does not call UnmarshalYAML for null values,
+// so a null in the YAML input leaves the value undefined.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Unmarshaler
+func (s *TimeSeconds) UnmarshalYAML(value *yaml.Node) error {
+	s.isNil = false
+	s.isDefined = true
+
+	var str string
+	err := value.Decode(&str)
+	if err != nil {
+		return err
+	}
+
+	s.underlying, err = time.Parse("15:04:05", str)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary implements the encoding BinaryMarshaler interface,
+// the value is encoded as a state byte (undefined, nil or defined) followed by the value.
+//
+// See: https://pkg.go.dev/encoding#BinaryMarshaler
+func (s TimeSeconds) MarshalBinary() ([]byte, error) {
+	if s.IsNil() {
+		return marshalBinaryNil(s.isDefined), nil
+	}
+
+	payload, err := s.underlying.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{binaryDefined}, payload...), nil
+}
+
+// UnmarshalBinary implements the encoding BinaryUnmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (s *TimeSeconds) UnmarshalBinary(d []byte) error {
+	isDefined, isNil, payload, err := unmarshalBinaryState(d)
+	if err != nil {
+		return err
+	}
+
+	var underlying time.Time
+	if isDefined && !isNil {
+		err := underlying.UnmarshalBinary(payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	*s = TimeSeconds{
+		underlying: underlying,
+		isDefined:  isDefined,
+		isNil:      isNil,
+	}
+
+	return nil
+}
+
+// EncodeMsgpack implements the msgpack CustomEncoder interface,
+// nil and undefined values are encoded as an extension type to keep the state.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomEncoder
+func (s TimeSeconds) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if s.IsNil() {
+		return encodeMsgpackNil(enc, s.isDefined)
+	}
+
+	return enc.EncodeString(s.String())
+}
+
+// DecodeMsgpack implements the msgpack CustomDecoder interface.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomDecoder
+func (s *TimeSeconds) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isDefined, isNil, err := decodeMsgpackState(dec)
+	if err != nil {
+		return err
+	}
+
+	if !isDefined || isNil {
+		*s = TimeSeconds{
+			isDefined: isDefined,
+			isNil:     isNil,
+		}
+
+		return nil
+	}
+
+	str, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+
+	*s, err = TimeSecondsFromString(str)
+	return err
+}
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (s *TimeSeconds) Scan(value interface{}) error {
+	s.isNil = (nil == value)
+	s.isDefined = true
+
+	if s.isNil {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case time.Duration:
+		// A duration is the time since midnight
+		if v < 0 || v >= 24*time.Hour {
+			return errors.New(fmt.Sprintf("duration out of range for time: %s", v))
+		}
+
+		s.underlying = time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(v)
+		return nil
+
+	case time.Time:
+		// Only the clock is kept when scanning from e.g. a timestamp column
+		s.underlying = time.Date(0, 1, 1, v.Hour(), v.Minute(), v.Second(), v.Nanosecond(), time.UTC)
+		return nil
+
+	case []byte:
+		value = string(v)
+	}
+
+	val, ok := value.(string)
+	if ok {
+		// Postgres returns "15:04:05" for time and "15:04:05+00" for timetz,
+		// the wall clock is kept for values with a zone.
+		for _, layout := range []string{"15:04:05", "15:04:05Z07", "15:04:05Z07:00"} {
+			t, err := time.Parse(layout, val)
+			if err == nil {
+				s.underlying = time.Date(0, 1, 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+				return nil
+			}
+		}
+	}
+	return convert.ConvertAssign(&s.underlying, value)
+}
+
+// Value implements the driver Valuer interface.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (s TimeSeconds) Value() (driver.Value, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+	return s.underlying, nil
+}
+
+// Scan time-of-day from Postgres
+func (s *TimeSeconds) ScanTime(v pgtype.Time) error {
+	s.isNil = !v.Valid
+	s.isDefined = true
+
+	if s.isNil {
+		s.underlying = time.Time{}
+		return nil
+	}
+
+	// pgtype.Time is microseconds since midnight (in pgx v5).
+	// Convert to a stable time.Time anchored to year 0 (0000-01-01) in UTC,
+	// matching the anchor date used by time.Parse when no date is specified.
+	us := v.Microseconds
+	hours := us / (3600 * 1_000_000)
+	us %= 3600 * 1_000_000
+	minutes := us / (60 * 1_000_000)
+	us %= 60 * 1_000_000
+	seconds := us / 1_000_000
+	micros := us % 1_000_000
+
+	s.underlying = time.Date(0, 1, 1, int(hours), int(minutes), int(seconds), int(micros*1000), time.UTC)
+
+	return nil
+}
+
+// Encode time-of-day to Postgres
+func (s TimeSeconds) TimeValue() (pgtype.Time, error) {
+	if s.IsNil() {
+		return pgtype.Time{
+			Microseconds: 0,
+			Valid:        false,
+		}, nil
+	}
+
+	// Extract time-of-day from your underlying time.Time
+	h, m, sec := s.underlying.Clock()
+	ns := s.underlying.Nanosecond()
+	us := int64(((h*60+m)*60+sec)*1_000_000) + int64(ns/1000)
+
+	return pgtype.Time{
+		Microseconds: us,
+		Valid:        true,
+	}, nil
+}
+
+// Timestamp is used to represent a timestamps according to the RFC3339 format.
+type Timestamp struct {
+	underlying time.Time
+	isDefined  bool
+	isNil      bool
+}
+
+// NewTimestamp creates a new Timestamp object, the instant is kept but converted to UTC and truncated to seconds.
+func NewTimestamp(underlying time.Time) Timestamp {
+	return Timestamp{
+		underlying: underlying.UTC().Truncate(time.Second),
+		isDefined:  true,
+		isNil:      false,
+	}
+}
+
+// NewTimestampFromPtr creates a new Timestamp object from a pointer.
+func NewTimestampFromPtr(underlying *time.Time) Timestamp {
+	if underlying != nil {
+		return NewTimestamp(*underlying)
+	}
+
+	return Timestamp{
+		isDefined: true,
+		isNil:     true,
+	}
+}
+
+// NewTimestampUndefined creates a new undefined Timestamp object.
+func NewTimestampUndefined() Timestamp {
+	return Timestamp{}
+}
+
+// NewTimestampFromUnix creates a new Timestamp object from the seconds since the Unix epoch.
+func NewTimestampFromUnix(sec int64) Timestamp {
+	return NewTimestamp(time.Unix(sec, 0))
+}
+
+// NewTimestampFromUnixMilli creates a new Timestamp object from the milliseconds since the Unix epoch,
+// the milliseconds are kept.
+func NewTimestampFromUnixMilli(msec int64) Timestamp {
+	return Timestamp{
+		underlying: time.UnixMilli(msec).UTC(),
+		isDefined:  true,
+		isNil:      false,
+	}
+}
+
+func TimestampFromStringPtr(strPtr *string) (Timestamp, error) {
+	if strPtr == nil {
+		return NewTimestampFromPtr(nil), nil
+	}
+
+	return TimestampFromString(*strPtr)
+}
+
+func TimestampFromString(str string) (Timestamp, error) {
+	s, _, err := TimestampFromStringWithZoneInfo(str)
+	return s, err
+}
+
+// TimestampFromStringWithZoneInfo parses the same formats as TimestampFromString and also returns true
+// if the string had an explicit zone, e.g. "2024-01-02T15:04:05+02:00" or "2024-01-02T15:04:05Z",
+// and false if it was zone-less such as "2024-01-02 15:04:05", which is interpreted as UTC.
+// It can be used to warn about ambiguous imports. An empty string is parsed as nil without a zone.
+func TimestampFromStringWithZoneInfo(str string) (Timestamp, bool, error) {
+	if str == "" {
+		return NewTimestampFromPtr(nil), false, nil
+	}
+
+	formats := []string{
+		"2006-01-02T15:04:05Z07:00",
+		"2006-01-02 15:04:05Z07:00",
+		"2006-01-02T15:04:05Z",
+		"2006-01-02 15:04:05Z",
+		"2006-01-02T15:04:05",
+		"2006-01-02 15:04:05",
+		"2006-01-02T15:04",
+		"2006-01-02 15:04",
+		"2006-01-02",
+		"1/2/06 15:04",
+		"1/2/06 15:04:05",
+		"1/2/2006 15:04",
+		"1/2/2006 15:04:05",
+	}
+
+	for _, format := range formats {
+		underlying, err := time.Parse(format, strings.TrimSpace(str))
+		if err == nil {
+			hasZone := strings.HasSuffix(format, "Z07:00") || strings.HasSuffix(format, "Z")
+
+			return Timestamp{
+				underlying: underlying,
+				isDefined:  true,
+				isNil:      false,
+			}, hasZone, nil
+		}
+	}
+
+	underlying, err := time.Parse("2006-01-02T15:04:05Z07:00", strings.TrimSpace(str))
+	if err != nil {
+		return Timestamp{}, false, err
+	}
+
+	return Timestamp{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}, true, nil
+}
+
+// MustTimestampFromString is like TimestampFromString but panics if the string cannot be parsed, like uuid.MustParse.
+// It is meant for tests and package-level variables, e.g. MustTimestampFromString("2024-01-02T15:04:05Z"),
+// never for untrusted input.
+func MustTimestampFromString(str string) Timestamp {
+	return must(TimestampFromString(str))
+}
+
+// TimestampsFromStrings parses the strings with TimestampFromString,
+// an error is returned for the first string that cannot be parsed.
+func TimestampsFromStrings(strs []string) ([]Timestamp, error) {
+	return fromStrings(strs, TimestampFromString)
+}
+
+// TimestampsToStrings converts the values to strings with String, a nil value becomes an empty string.
+func TimestampsToStrings(values []Timestamp) []string {
+	return toStrings(values)
+}
+
+// timestampZoneAbbreviations are the zone abbreviations recognized by TimestampFromStringFlexible,
+// mapped to their offset from UTC in seconds and guarded by timestampZoneAbbreviationsMu.
+//
+// Zone abbreviations are ambiguous, e.g. CST is used for both China Standard Time and Central Standard Time,
+// so only a few unambiguous abbreviations are recognized and more can be added with RegisterTimestampZoneAbbreviation.
+var (
+	timestampZoneAbbreviations = map[string]int{
+		"UTC":  0,
+		"GMT":  0,
+		"CET":  1 * 60 * 60,
+		"CEST": 2 * 60 * 60,
+	}
+	timestampZoneAbbreviationsMu sync.RWMutex
+)
+
+// RegisterTimestampZoneAbbreviation makes TimestampFromStringFlexible recognize the zone abbreviation,
+// with the offset from UTC in seconds, e.g. RegisterTimestampZoneAbbreviation("EET", 2*60*60).
+//
+// It is meant to be called at init time, but it is safe for concurrent use with TimestampFromStringFlexible.
+// It panics if the abbreviation is already registered, since the offset of a recognized abbreviation should not change.
+func RegisterTimestampZoneAbbreviation(abbreviation string, offset int) {
+	abbreviation = strings.ToUpper(abbreviation)
+
+	timestampZoneAbbreviationsMu.Lock()
+	defer timestampZoneAbbreviationsMu.Unlock()
+
+	if _, ok := timestampZoneAbbreviations[abbreviation]; ok {
+		panic("types: RegisterTimestampZoneAbbreviation called twice for " + abbreviation)
+	}
+
+	timestampZoneAbbreviations[abbreviation] = offset
+}
+
+// TimestampFromStringFlexible parses the same formats as TimestampFromString,
+// but also accepts a trailing zone abbreviation, e.g. "2024-01-02 14:30:00 CET".
+// UTC, GMT, CET and CEST are recognized and more can be added with RegisterTimestampZoneAbbreviation.
+//
+// Note that the abbreviation decides the offset, so "CET" is always UTC+1 even in the summer,
+// the returned Timestamp is normalized to UTC.
+func TimestampFromStringFlexible(str string) (Timestamp, error) {
+	str = strings.TrimSpace(str)
+
+	i := strings.LastIndex(str, " ")
+	if i < 0 {
+		return TimestampFromString(str)
+	}
+
+	timestampZoneAbbreviationsMu.RLock()
+	offset, ok := timestampZoneAbbreviations[strings.ToUpper(str[i+1:])]
+	timestampZoneAbbreviationsMu.RUnlock()
+
+	if !ok {
+		return TimestampFromString(str)
+	}
+
+	// Only the wall clock is kept, since the abbreviation decides the zone
+	wallClock, err := TimestampFromString(str[:i])
+	if err != nil {
+		return Timestamp{}, err
+	}
+
+	if wallClock.IsNil() {
+		return Timestamp{}, errors.New("invalid timestamp, missing date and time: " + str)
+	}
+
+	w := wallClock.underlying
+
+	return NewTimestamp(time.Date(w.Year(), w.Month(), w.Day(), w.Hour(), w.Minute(), w.Second(), w.Nanosecond(), time.FixedZone(str[i+1:], offset))), nil
+}
+
+// String output Timestamp
+func (s Timestamp) String() string {
+	// If the value is nil we return an empty string
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.underlying.Format("2006-01-02T15:04:05Z07:00")
+}
+
+// CSVValue returns the value for a CSV field, which is the same as String and an empty string for nil.
+func (s Timestamp) CSVValue() string {
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.String()
+}
+
+// Timestamp returns the time.Time value.
+func (s Timestamp) Timestamp() time.Time {
+	return s.underlying
+}
+
+// TimestampPtr returns the time.Time value as a pointer.
+func (s Timestamp) TimestampPtr() *time.Time {
+	if s.IsNil() {
+		return nil
+	}
+	return &s.underlying
+}
+
+// IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
+func (s Timestamp) IsDefined() bool {
+	return s.isDefined
+}
+
+// IsNil returns true if the value is nil or undefined.
+func (s Timestamp) IsNil() bool {
+	// if the value is undefined, it is nil even though "isNil" will be set to false
+	if !s.isDefined {
+		return true
+	}
+
+	return s.isNil
+}
+
+// IsZero checks if Timestamp is nil, which is specifically used by sqlboiler queries
+func (s Timestamp) IsZero() bool { return s.IsNil() }
+
+// Ptr returns the pointer for Timestamp, but returns nil if undefined.
+func (s Timestamp) Ptr() *Timestamp {
+	if !s.isDefined {
+		return nil
+	}
+
+	return &s
+}
+
+// Val returns the value of a Timestamp-pointer,
+// will return an undefined Timestamp if the pointer is nil.
+func (s *Timestamp) Val() Timestamp {
+	if s == nil {
+		return NewTimestampFromPtr(nil)
+	}
+
+	return *s
+}
+
+// Set sets the Timestamp to the value in place, which is the same as assigning NewTimestamp(v).
+func (s *Timestamp) Set(v time.Time) {
+	*s = NewTimestamp(v)
+}
+
+// SetNil sets the Timestamp to nil in place, which is the same as assigning NewTimestampFromPtr(nil), so it is still defined.
+func (s *Timestamp) SetNil() {
+	*s = NewTimestampFromPtr(nil)
+}
+
+// SetUndefined sets the Timestamp to undefined in place, which is the same as assigning NewTimestampUndefined().
+func (s *Timestamp) SetUndefined() {
+	*s = NewTimestampUndefined()
+}
+
+// Validate validates the Timestamp against the rules, see Rule.
+func (s Timestamp) Validate(rules ...Rule) error {
+	return validate(s, rules)
+}
+
+// Compare implements the Ordered interface, nil values sort before all non-nil values.
+func (s Timestamp) Compare(other any) (int, error) {
+	return compareAny(s, other, func(a, b Timestamp) int { return a.underlying.Compare(b.underlying) })
+}
+
+// Hash returns a hash of the state and the value, which can be used for change detection, see HashStruct.
+// The instant is hashed in UTC, so the same instant in different zones hashes equal.
+func (s Timestamp) Hash() uint64 {
+	s.underlying = s.underlying.UTC()
+
+	return hashBinary(s)
+}
+
+func (t Timestamp) After(other Timestamp) bool {
+	return t.Timestamp().After(other.Timestamp())
+}
+
+func (t Timestamp) Before(other Timestamp) bool {
+	return t.Timestamp().Before(other.Timestamp())
+}
+
+func (t Timestamp) Equal(other Timestamp) bool {
+	return t.Timestamp().Equal(other.Timestamp())
+}
+
+// NotAfterNow returns an error if the timestamp is after the current time,
+// the location is used to present the timestamps in the error. A nil Timestamp is considered valid.
+func (s Timestamp) NotAfterNow(location *time.Location) error {
+	if s.IsNil() {
+		return nil
+	}
+
+	now := Now()
+	if s.underlying.After(now) {
+		return errors.New(fmt.Sprintf("timestamp %s is after now (%s)",
+			s.underlying.In(location).Format(time.RFC3339),
+			now.In(location).Format(time.RFC3339),
+		))
+	}
+
+	return nil
+}
+
+// MinutesUntil returns the minutes until the given timestamp
+func (from Timestamp) MinutesUntil(to Timestamp) int {
+	return int(to.Timestamp().Sub(from.Timestamp()).Minutes())
+}
+
+// Date returns the calendar date of the Timestamp in UTC, since timestamps are stored in UTC.
+// Use DateIn to get the date in another location.
+func (t Timestamp) Date() Date {
+	return NewDate(t.Timestamp())
+}
+
+// DateIn returns the calendar date of the Timestamp in the given location,
+// e.g. the school day of an attendance record in the timezone of the school.
+// A nil Timestamp returns a nil Date.
+func (t Timestamp) DateIn(location *time.Location) Date {
+	if t.IsNil() {
+		return NewDateFromPtr(nil)
+	}
+
+	return NewDate(t.underlying.In(location))
+}
+
+// Returns a new Timestamp with the time set to the start of the day,
+// the day is the calendar day of the Timestamp in the given location.
+func (s Timestamp) StartOfDay(location *time.Location) Timestamp {
+	year, month, day := s.underlying.In(location).Date()
+
+	return NewTimestamp(time.Date(
+		year,
+		month,
+		day,
+		0,
+		0,
+		0,
+		0,
+		location,
+	))
+}
+
+// Returns a new Timestamp with the time set to the last nanosecond of the day,
+// the day is the calendar day of the Timestamp in the given location.
+func (s Timestamp) EndOfDay(location *time.Location) Timestamp {
+	year, month, day := s.underlying.In(location).Date()
+
+	// NewTimestamp is not used since it truncates the nanoseconds.
+	return Timestamp{
+		underlying: time.Date(
+			year,
+			month,
+			day,
+			23,
+			59,
+			59,
+			999999999,
+			location,
+		).UTC(),
+		isDefined: true,
+		isNil:     false,
+	}
+}
+
+// In returns a new Timestamp for the same instant in the given location,
+// which is used by the following calendar operations in a chain, e.g. ts.In(loc).StartOfWeek().
+// A nil Timestamp is returned as is.
+func (s Timestamp) In(location *time.Location) Timestamp {
+	if s.IsNil() {
+		return s
+	}
+
+	s.underlying = s.underlying.In(location)
+
+	return s
+}
+
+// Add returns a new Timestamp with the duration added, keeping the location of the Timestamp.
+// A nil Timestamp is returned as is.
+func (s Timestamp) Add(d time.Duration) Timestamp {
+	if s.IsNil() {
+		return s
+	}
+
+	s.underlying = s.underlying.Add(d)
+
+	return s
+}
+
+// Sub returns the duration between the Timestamp and the other Timestamp (s - other).
+// If any of the timestamps is nil, 0 is returned.
+func (s Timestamp) Sub(other Timestamp) time.Duration {
+	if s.IsNil() || other.IsNil() {
+		return 0
+	}
+
+	return s.underlying.Sub(other.underlying)
+}
+
+// MinTimestamp returns the earliest of the timestamps, nil values are skipped.
+// If there are no non-nil values a nil Timestamp is returned.
+func MinTimestamp(values []Timestamp) Timestamp {
+	return extreme(values, func(a, b Timestamp) bool { return a.underlying.Before(b.underlying) })
+}
+
+// MaxTimestamp returns the latest of the timestamps, nil values are skipped.
+// If there are no non-nil values a nil Timestamp is returned.
+func MaxTimestamp(values []Timestamp) Timestamp {
+	return extreme(values, func(a, b Timestamp) bool { return a.underlying.After(b.underlying) })
+}
+
+// Clamp returns the Timestamp bounded by low and high, where a nil bound is unbounded.
+// A nil Timestamp is returned as is.
+func (s Timestamp) Clamp(low, high Timestamp) Timestamp {
+	if s.IsNil() {
+		return s
+	}
+
+	if !low.IsNil() && s.underlying.Before(low.underlying) {
+		return low
+	}
+
+	if !high.IsNil() && s.underlying.After(high.underlying) {
+		return high
+	}
+
+	return s
+}
+
+// Truncate returns a new Timestamp rounded down to a multiple of the duration,
+// keeping the location of the Timestamp. A nil Timestamp is returned as is.
+//
+// See https://pkg.go.dev/time#Time.Truncate for how the rounding is done.
+func (s Timestamp) Truncate(d time.Duration) Timestamp {
+	if s.IsNil() {
+		return s
+	}
+
+	s.underlying = s.underlying.Truncate(d)
+
+	return s
+}
+
+// BucketTimestamps counts the timestamps per interval, e.g. per hour for a histogram,
+// the keys are the start of the buckets. Nil timestamps are skipped.
+//
+// The buckets are aligned to the wall clock in the given location, so daily buckets start at midnight in the location.
+func BucketTimestamps(ts []Timestamp, interval time.Duration, location *time.Location) map[Timestamp]int {
+	buckets := make(map[Timestamp]int)
+
+	for i := range ts {
+		if ts[i].IsNil() {
+			continue
+		}
+
+		// Truncate the wall clock, since time.Truncate is relative to the zero time in UTC
+		local := ts[i].underlying.In(location)
+		wall := time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), local.Minute(), local.Second(), local.Nanosecond(), time.UTC).Truncate(interval)
+		start := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), location)
+
+		buckets[NewTimestamp(start)]++
+	}
+
+	return buckets
+}
+
+// Unix returns the seconds since the Unix epoch, or 0 if the Timestamp is nil.
+func (s Timestamp) Unix() int64 {
+	if s.IsNil() {
+		return 0
+	}
+
+	return s.underlying.Unix()
+}
+
+// UnixMilli returns the milliseconds since the Unix epoch, or 0 if the Timestamp is nil.
+func (s Timestamp) UnixMilli() int64 {
+	if s.IsNil() {
+		return 0
+	}
+
+	return s.underlying.UnixMilli()
+}
+
+// UnixTimestamp is a Timestamp which is represented in JSON as a number of seconds since the Unix epoch,
+// for upstreams that send numbers instead of RFC3339 strings.
+//
+// Everything except MarshalJSON and UnmarshalJSON behaves as Timestamp.
+type UnixTimestamp struct {
+	Timestamp
+}
+
+// NewUnixTimestamp wraps the Timestamp so it is represented as seconds since the Unix epoch in JSON.
+func NewUnixTimestamp(s Timestamp) UnixTimestamp {
+	return UnixTimestamp{Timestamp: s}
+}
+
+// MarshalJSON implements the json Marshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Marshaler
+func (s UnixTimestamp) MarshalJSON() ([]byte, error) {
+	if s.IsNil() {
+		return nullBytes, nil
+	}
+
+	return strconv.AppendInt(nil, s.Unix(), 10), nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface, it accepts a JSON number of seconds.
+//
+// See: https://pkg.go.dev/encoding/json#Unmarshaler
+func (s *UnixTimestamp) UnmarshalJSON(d []byte) error {
+	s.isNil = isNullBytes(d)
+	s.isDefined = true
+
+	if s.isNil {
+		return nil
+	}
+
+	var sec int64
+	err := json.Unmarshal(d, &sec)
+	if err != nil {
+		return err
+	}
+
+	s.underlying = NewTimestampFromUnix(sec).underlying
+
+	return nil
+}
+
+// TruncateToMinute returns a new Timestamp without the seconds, see Truncate.
+func (s Timestamp) TruncateToMinute() Timestamp {
+	return s.Truncate(time.Minute)
+}
+
+// TimestampMinute is a Timestamp which is marshaled to JSON with minute precision, "2006-01-02T15:04Z",
+// which can be used for fields where the seconds are only noise.
+//
+// Everything except MarshalJSON and UnmarshalJSON behaves as Timestamp.
+type TimestampMinute struct {
+	Timestamp
+}
+
+// NewTimestampMinuteMode wraps the Timestamp so it is marshaled to JSON with minute precision.
+func NewTimestampMinuteMode(s Timestamp) TimestampMinute {
+	return TimestampMinute{Timestamp: s}
+}
+
+// MarshalJSON implements the json Marshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Marshaler
+func (s TimestampMinute) MarshalJSON() ([]byte, error) {
+	if s.IsNil() {
+		return nullBytes, nil
+	}
+
+	return quoteTime(s.underlying, "2006-01-02T15:04Z07:00"), nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface,
+// both minute and second precision are accepted and the seconds are truncated.
+//
+// See: https://pkg.go.dev/encoding/json#Unmarshaler
+func (s *TimestampMinute) UnmarshalJSON(d []byte) error {
+	s.isNil = isNullBytes(d)
+	s.isDefined = true
+
+	if s.isNil {
+		return nil
+	}
+
+	var str string
+	err := json.Unmarshal(d, &str)
+	if err != nil {
+		return err
+	}
+
+	underlying, err := time.Parse("2006-01-02T15:04Z07:00", str)
+	if err != nil {
+		underlying, err = time.Parse("2006-01-02T15:04:05Z07:00", str)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.underlying = underlying.UTC().Truncate(time.Minute)
+
+	return nil
+}
+
+// timestampLocalLocation is the location of the wall clock stored by TimestampLocal, see SetTimestampLocalLocation.
+var timestampLocalLocation = newSetting(time.Local)
+
+// SetTimestampLocalLocation sets the location of the wall clock stored by TimestampLocal,
+// the default is time.Local and a nil location restores it.
+//
+// It is meant to be called once at startup, since it changes how all TimestampLocal values are stored and scanned,
+// but it is safe for concurrent use.
+func SetTimestampLocalLocation(location *time.Location) {
+	if location == nil {
+		location = time.Local
+	}
+
+	timestampLocalLocation.set(location)
+}
+
+// TimestampLocal is a Timestamp which is stored in a Postgres timestamp column (without time zone)
+// as the wall clock in the location set with SetTimestampLocalLocation, while Timestamp is meant for timestamptz columns.
+//
+// The driver value is the wall clock with the location UTC, so that neither the driver nor Postgres converts it,
+// and a scanned value is interpreted as the wall clock in the location.
+// Everything except Scan, Value, ScanTimestamp and TimestampValue behaves as Timestamp.
+type TimestampLocal struct {
+	Timestamp
+}
+
+// NewTimestampLocal wraps the Timestamp so it is stored as the wall clock in the location set with SetTimestampLocalLocation.
+func NewTimestampLocal(s Timestamp) TimestampLocal {
+	return TimestampLocal{Timestamp: s}
+}
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface,
+// the value is interpreted as the wall clock in the location set with SetTimestampLocalLocation regardless of its location.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (s *TimestampLocal) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		s.Timestamp = NewTimestampFromPtr(nil)
+		return nil
+
+	case []byte:
+		value = string(v)
+	}
+
+	var wallClock time.Time
+
+	switch v := value.(type) {
+	case time.Time:
+		wallClock = v
+
+	case string:
+		var err error
+
+		wallClock, err = time.Parse("2006-01-02 15:04:05.999999999", v)
+		if err != nil {
+			wallClock, err = time.Parse("2006-01-02T15:04:05.999999999", v)
+			if err != nil {
+				return err
+			}
+		}
+
+	default:
+		return errors.New(fmt.Sprintf("incompatible type for timestamp: %T", value))
+	}
+
+	s.Timestamp = NewTimestamp(inLocation(wallClock, timestampLocalLocation.get()))
+
+	return nil
+}
+
+// Value implements the driver Valuer interface, the value is the wall clock in the location set with SetTimestampLocalLocation.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (s TimestampLocal) Value() (driver.Value, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+
+	return s.wallClock(), nil
+}
+
+// ScanTimestamp implements the [pgtype.TimestampScanner] interface, see Scan.
+func (s *TimestampLocal) ScanTimestamp(v pgtype.Timestamp) error {
+	if !v.Valid {
+		s.Timestamp = NewTimestampFromPtr(nil)
+		return nil
+	}
+
+	return s.Scan(v.Time)
+}
+
+// TimestampValue implements the [pgtype.TimestampValuer] interface, see Value.
+func (s TimestampLocal) TimestampValue() (pgtype.Timestamp, error) {
+	if s.IsNil() {
+		return pgtype.Timestamp{}, nil
+	}
+
+	return pgtype.Timestamp{
+		Time:             s.wallClock(),
+		InfinityModifier: pgtype.Finite,
+		Valid:            true,
+	}, nil
+}
+
+// wallClock returns the wall clock in the location set with SetTimestampLocalLocation with the location UTC.
+func (s TimestampLocal) wallClock() time.Time {
+	return inLocation(s.underlying.In(timestampLocalLocation.get()), time.UTC)
+}
+
+// inLocation returns the same wall clock as t in the location, which is a different instant unless the offsets are equal.
+func inLocation(t time.Time, location *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), location)
+}
+
+// AddDate returns a new Timestamp with the years, months and days added, keeping the location of the Timestamp.
+// A nil Timestamp is returned as is.
+//
+// See https://pkg.go.dev/time#Time.AddDate for how overflowing dates are normalized.
+func (s Timestamp) AddDate(years, months, days int) Timestamp {
+	if s.IsNil() {
+		return s
+	}
+
+	s.underlying = s.underlying.AddDate(years, months, days)
+
+	return s
+}
+
+// StartOfWeek returns a new Timestamp with the time set to the start of Monday in the same week,
+// in the location of the Timestamp (see In). A nil Timestamp is returned as is.
+func (s Timestamp) StartOfWeek() Timestamp {
+	if s.IsNil() {
+		return s
+	}
+
+	daysSinceMonday := (int(s.underlying.Weekday()) + 6) % 7
+
+	s.underlying = time.Date(
+		s.underlying.Year(),
+		s.underlying.Month(),
+		s.underlying.Day()-daysSinceMonday,
+		0,
+		0,
+		0,
+		0,
+		s.underlying.Location(),
+	)
+
+	return s
+}
+
+// NextWeekdayTime returns the next instant strictly after the Timestamp which is on the weekday
+// at the time of day in the given location, e.g. next Monday at 08:00 for recurring schedules.
+// A nil Timestamp is returned as is, and a nil Timestamp is returned if the time of day is nil.
+func (s Timestamp) NextWeekdayTime(weekday time.Weekday, timeOfDay Time, location *time.Location) Timestamp {
+	if s.IsNil() {
+		return s
+	}
+
+	if timeOfDay.IsNil() {
+		return NewTimestampFromPtr(nil)
+	}
+
+	local := s.underlying.In(location)
+	hour, minute, second := timeOfDay.underlying.Clock()
+
+	// The weekday is found within a week, the 8th day is needed if the Timestamp is on the weekday after the time of day
+	for days := 0; days <= 7; days++ {
+		candidate := time.Date(local.Year(), local.Month(), local.Day()+days, hour, minute, second, 0, location)
+		if candidate.Weekday() == weekday && candidate.After(s.underlying) {
+			return NewTimestamp(candidate)
+		}
+	}
+
+	// Not reachable, since every weekday occurs within 8 days
+	return NewTimestampFromPtr(nil)
+}
+
+// MarshalJSON implements the json Marshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Marshaler
+func (s Timestamp) MarshalJSON() ([]byte, error) {
+	if s.IsNil() {
+		return nullBytes, nil
+	}
+
+	return quoteTime(s.underlying, "2006-01-02T15:04:05Z07:00"), nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Unmarshaler
+func (s *Timestamp) UnmarshalJSON(d []byte) error {
+	s.isNil = isNullBytes(d)
+	s.isDefined = true
+
+	if s.isNil {
+		return nil
+	}
+
+	var str string
+	err := json.Unmarshal(d, &str)
+	if err != nil {
+		return err
+	}
+
+	s.underlying, err = time.Parse("2006-01-02T15:04:05Z07:00", str)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalYAML implements the yaml Marshaler interface.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Marshaler
+func (s Timestamp) MarshalYAML() (interface{}, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+
+	return s.underlying.Format("2006-01-02T15:04:05Z07:00"), nil
+}
+
+// UnmarshalYAML implements the yaml Unmarshaler interface.
+//
+// Note that yaml.v3 does not call UnmarshalYAML for null values,
+// so a null in the YAML input leaves the value undefined.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Unmarshaler
+func (s *Timestamp) UnmarshalYAML(value *yaml.Node) error {
+	s.isNil = false
+	s.isDefined = true
+
+	var str string
+	err := value.Decode(&str)
+	if err != nil {
+		return err
+	}
+
+	s.underlying, err = time.Parse("2006-01-02T15:04:05Z07:00", str)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary implements the encoding BinaryMarshaler interface,
+// the value is encoded as a state byte (undefined, nil or defined) followed by the value.
+//
+// See: https://pkg.go.dev/encoding#BinaryMarshaler
+func (s Timestamp) MarshalBinary() ([]byte, error) {
+	if s.IsNil() {
+		return marshalBinaryNil(s.isDefined), nil
+	}
+
+	payload, err := s.underlying.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{binaryDefined}, payload...), nil
+}
+
+// UnmarshalBinary implements the encoding BinaryUnmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (s *Timestamp) UnmarshalBinary(d []byte) error {
+	isDefined, isNil, payload, err := unmarshalBinaryState(d)
+	if err != nil {
+		return err
+	}
+
+	var underlying time.Time
+	if isDefined && !isNil {
+		err := underlying.UnmarshalBinary(payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	*s = Timestamp{
+		underlying: underlying,
+		isDefined:  isDefined,
+		isNil:      isNil,
+	}
+
+	return nil
+}
+
+// EncodeMsgpack implements the msgpack CustomEncoder interface,
+// nil and undefined values are encoded as an extension type to keep the state.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomEncoder
+func (s Timestamp) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if s.IsNil() {
+		return encodeMsgpackNil(enc, s.isDefined)
+	}
+
+	return enc.EncodeTime(s.underlying)
+}
+
+// DecodeMsgpack implements the msgpack CustomDecoder interface.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomDecoder
+func (s *Timestamp) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isDefined, isNil, err := decodeMsgpackState(dec)
+	if err != nil {
+		return err
+	}
+
+	if !isDefined || isNil {
+		*s = Timestamp{
+			isDefined: isDefined,
+			isNil:     isNil,
+		}
+
+		return nil
+	}
+
+	underlying, err := dec.DecodeTime()
+	if err != nil {
+		return err
+	}
+
+	// The msgpack timestamp has no time zone, NewTimestamp is not used
+	// since it truncates the nanoseconds, e.g. of an EndOfDay value.
+	*s = Timestamp{
+		underlying: underlying.UTC(),
+		isDefined:  true,
+		isNil:      false,
+	}
+
+	return nil
+}
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (s *Timestamp) Scan(value interface{}) error {
+	s.isNil = (nil == value)
+	s.isDefined = true
+
+	if s.isNil {
+		return nil
+	}
+
+	return convert.ConvertAssign(&s.underlying, value)
+}
+
+// Value implements the driver Valuer interface.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (s Timestamp) Value() (driver.Value, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+	return s.underlying, nil
+}
+
+// ScanTimestamp implements the [pgtype.TimestampScanner] interface.
+func (ts *Timestamp) ScanTimestamp(v pgtype.Timestamp) error {
+	ts.isNil = !v.Valid
+	ts.isDefined = true
+	ts.underlying = v.Time
+
+	return nil
+}
+
+// TimestampValue implements the [pgtype.TimestampValuer] interface.
+func (ts Timestamp) TimestampValue() (pgtype.Timestamp, error) {
+	return pgtype.Timestamp{
+		Time:             ts.underlying,
+		InfinityModifier: pgtype.Finite,
+		Valid:            !ts.IsNil(),
+	}, nil
+}
+
+// UUID is used to represent a UUID.
+type UUID struct {
+	underlying uuid.UUID
+	isDefined  bool
+	isNil      bool
+}
+
+// NewRandomUUID generates a new UUID object.
+func NewRandomUUID() UUID {
+	return UUID{
+		underlying: uuid.New(),
+		isDefined:  true,
+		isNil:      false,
+	}
+}
+
+// NewUUID creates a new UUID object.
+func NewUUID(underlying uuid.UUID) UUID {
+	return UUID{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}
+}
+
+// NewUUIDFromPtr creates a new UUID object from a pointer.
+func NewUUIDFromPtr(underlying *uuid.UUID) UUID {
+	if underlying != nil {
+		return NewUUID(*underlying)
+	}
+
+	return UUID{
+		isDefined: true,
+		isNil:     true,
+	}
+}
+
+// NewUUIDUndefined creates a new undefined UUID object.
+func NewUUIDUndefined() UUID {
+	return UUID{}
+}
+
+func UUIDFromStringPtr(strPtr *string) (UUID, error) {
+	if strPtr == nil {
+		return NewUUIDFromPtr(nil), nil
+	}
+
+	return UUIDFromString(*strPtr)
+}
+
+func UUIDFromString(str string) (UUID, error) {
+	if str == "" {
+		return NewUUIDFromPtr(nil), nil
+	}
+
+	underlying, err := uuid.Parse(strings.TrimSpace(str))
+	if err != nil {
+		return UUID{}, err
+	}
+
+	return UUID{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}, nil
+}
+
+// MustUUIDFromString is like UUIDFromString but panics if the string cannot be parsed, like uuid.MustParse.
+// It is meant for tests and package-level variables, e.g. MustUUIDFromString("123e4567-e89b-12d3-a456-426614174000"),
+// never for untrusted input.
+func MustUUIDFromString(str string) UUID {
+	return must(UUIDFromString(str))
+}
+
+// UUIDFromStringV4Only parses the UUID like UUIDFromString,
+// but returns an error if the UUID is not a version 4 (random) UUID.
+func UUIDFromStringV4Only(str string) (UUID, error) {
+	u, err := UUIDFromString(str)
+	if err != nil {
+		return UUID{}, err
+	}
+
+	if u.IsNil() {
+		return u, nil
+	}
+
+	err = u.RequireVersion(4)
+	if err != nil {
+		return UUID{}, err
+	}
+
+	return u, nil
+}
+
+// UUIDsFromStringsE parses the strings with UUIDFromString,
+// unlike UUIDsFromStrings it returns an error for the first invalid UUID instead of panicking.
+func UUIDsFromStringsE(strs []string) ([]UUID, error) {
+	return fromStrings(strs, UUIDFromString)
+}
+
+// UUIDsFromStrings parses the strings as UUIDs and panics if any of them is invalid,
+// so it should only be used for trusted input. Use UUIDsFromStringsE for e.g. query parameters.
+func UUIDsFromStrings(strings []string) []UUID {
+	uuids := make([]UUID, len(strings))
+	for i := range strings {
+		uuids[i] = NewUUID(uuid.MustParse(strings[i]))
+	}
+	return uuids
+}
+
+func UUIDsToStrings(uuids []UUID) []string {
+	strings := make([]string, len(uuids))
+	for i := range uuids {
+		strings[i] = uuids[i].String()
+	}
+	return strings
+}
+
+// DuplicateUUIDs returns the UUIDs that occur more than once, each returned once
+// in the order of their first occurrence. Nil UUIDs are ignored.
+func DuplicateUUIDs(uuids []UUID) []UUID {
+	counts := make(map[uuid.UUID]int, len(uuids))
+	duplicates := []UUID{}
+
+	for i := range uuids {
+		if uuids[i].IsNil() {
+			continue
+		}
+
+		counts[uuids[i].underlying]++
+
+		if counts[uuids[i].underlying] == 2 {
+			duplicates = append(duplicates, uuids[i])
+		}
+	}
+
+	return duplicates
+}
+
+// HasDuplicateUUIDs returns true if any UUID occurs more than once. Nil UUIDs are ignored.
+func HasDuplicateUUIDs(uuids []UUID) bool {
+	seen := make(map[uuid.UUID]bool, len(uuids))
+
+	for i := range uuids {
+		if uuids[i].IsNil() {
+			continue
+		}
+
+		if seen[uuids[i].underlying] {
+			return true
+		}
+
+		seen[uuids[i].underlying] = true
+	}
+
+	return false
+}
+
+// IndexByUUID returns a map of the items keyed by the UUID returned by key, e.g. the ID of each record.
+// Items with a nil or undefined key are skipped, and if several items have the same key the last one wins.
+func IndexByUUID[T any](items []T, key func(T) UUID) map[UUID]T {
+	index := make(map[UUID]T, len(items))
+
+	for _, item := range items {
+		id := key(item)
+		if id.IsNil() {
+			continue
+		}
+
+		index[id] = item
+	}
+
+	return index
+}
+
+// UpsertByKey merges the incoming values into the existing values by the UUID returned by key,
+// an incoming value replaces the existing value with the same key and new keys are appended.
+//
+// The order of the existing values is kept, followed by the new values in the incoming order.
+// Values with a nil key never match and are always appended.
+func UpsertByKey[T any](existing, incoming []T, key func(T) UUID) []T {
+	result := make([]T, 0, len(existing)+len(incoming))
+	indexes := make(map[uuid.UUID]int, len(existing)+len(incoming))
+
+	for _, values := range [][]T{existing, incoming} {
+		for _, value := range values {
+			k := key(value)
+			if k.IsNil() {
+				result = append(result, value)
+				continue
+			}
+
+			if i, ok := indexes[k.underlying]; ok {
+				result[i] = value
+				continue
+			}
+
+			indexes[k.underlying] = len(result)
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
+
+// String output UUID
+func (s UUID) String() string {
+	// If the value is nil we return an empty string
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.underlying.String()
+}
+
+// CSVValue returns the value for a CSV field, which is the same as String and an empty string for nil.
+func (s UUID) CSVValue() string {
+	if s.IsNil() {
+		return ""
+	}
+
+	return s.String()
+}
+
+// UUID returns the uuid.UUID value.
+func (s UUID) UUID() uuid.UUID {
+	return s.underlying
+}
+
+// UUIDPtr returns the uuid.UUID value as a pointer.
+func (s UUID) UUIDPtr() *uuid.UUID {
+	if s.IsNil() {
+		return nil
+	}
+	return &s.underlying
+}
+
+// IsDefined returns true if the value was defined in the JSON input or was scanned from the database.
+func (s UUID) IsDefined() bool {
+	return s.isDefined
+}
+
+// IsNil returns true if the value is nil or undefined.
+func (s UUID) IsNil() bool {
+	// if the value is undefined, it is nil even though "isNil" will be set to false
+	if !s.isDefined {
+		return true
+	}
+
+	return s.isNil
+}
+
+// IsZero checks if UUID is nil, which is specifically used by sqlboiler queries
+func (s UUID) IsZero() bool { return s.IsNil() }
+
+// Ptr returns the pointer for UUID, but returns nil if undefined.
+func (s UUID) Ptr() *UUID {
+	if !s.isDefined {
+		return nil
+	}
+
+	return &s
+}
+
+// Val returns the value of a UUID-pointer,
+// will return an undefined UUID if the pointer is nil.
+func (s *UUID) Val() UUID {
+	if s == nil {
+		return NewUUIDFromPtr(nil)
+	}
+
+	return *s
+}
+
+// Set sets the UUID to the value in place, which is the same as assigning NewUUID(v).
+func (s *UUID) Set(v uuid.UUID) {
+	*s = NewUUID(v)
+}
+
+// SetNil sets the UUID to nil in place, which is the same as assigning NewUUIDFromPtr(nil), so it is still defined.
+func (s *UUID) SetNil() {
+	*s = NewUUIDFromPtr(nil)
+}
+
+// SetUndefined sets the UUID to undefined in place, which is the same as assigning NewUUIDUndefined().
+func (s *UUID) SetUndefined() {
+	*s = NewUUIDUndefined()
+}
+
+// RequireVersion returns an error if the UUID is not of the given version, e.g. 4 for random UUIDs,
+// or if the UUID is nil.
+func (s UUID) RequireVersion(v int) error {
+	if s.IsNil() {
+		return errors.New("uuid is nil")
+	}
+
+	if int(s.underlying.Version()) != v {
+		return errors.New(fmt.Sprintf("uuid %s is version %d, expected version %d", s.String(), s.underlying.Version(), v))
+	}
+
+	return nil
+}
+
+// Validate validates the UUID against the rules, see Rule.
+func (s UUID) Validate(rules ...Rule) error {
+	return validate(s, rules)
+}
+
+// Compare implements the Ordered interface, nil values sort before all non-nil values and UUIDs are compared byte-wise.
+func (s UUID) Compare(other any) (int, error) {
+	return compareAny(s, other, func(a, b UUID) int { return bytes.Compare(a.underlying[:], b.underlying[:]) })
+}
+
+// Hash returns a hash of the state and the value, which can be used for change detection, see HashStruct.
+func (s UUID) Hash() uint64 {
+	return hashBinary(s)
+}
+
 // MarshalJSON implements the json Marshaler interface.
 //
-// See: https://pkg.go.dev/encoding/json#Marshaler
-func (s UUID) MarshalJSON() ([]byte, error) {
+// See: https://pkg.go.dev/encoding/json#Marshaler
+func (s UUID) MarshalJSON() ([]byte, error) {
+	if s.IsNil() {
+		return nullBytes, nil
+	}
+
+	jsonBytes, err := json.Marshal(s.underlying)
+	if err != nil {
+		return nil, errors.Wrap(err, s.String())
+	}
+
+	return jsonBytes, nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+//
+// See: https://pkg.go.dev/encoding/json#Unmarshaler
+func (s *UUID) UnmarshalJSON(d []byte) error {
+	s.isNil = isNullBytes(d)
+	s.isDefined = true
+
+	if s.isNil {
+		return nil
+	}
+
+	err := json.Unmarshal(d, &s.underlying)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalYAML implements the yaml Marshaler interface.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Marshaler
+func (s UUID) MarshalYAML() (interface{}, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+
+	return s.underlying.String(), nil
+}
+
+// UnmarshalYAML implements the yaml Unmarshaler interface.
+//
+// Note that yaml.v3 does not call UnmarshalYAML for null values,
+// so a null in the YAML input leaves the value undefined.
+//
+// See: https://pkg.go.dev/gopkg.in/yaml.v3#Unmarshaler
+func (s *UUID) UnmarshalYAML(value *yaml.Node) error {
+	s.isNil = false
+	s.isDefined = true
+
+	var str string
+	err := value.Decode(&str)
+	if err != nil {
+		return err
+	}
+
+	s.underlying, err = uuid.Parse(str)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary implements the encoding BinaryMarshaler interface,
+// the value is encoded as a state byte (undefined, nil or defined) followed by the value.
+//
+// See: https://pkg.go.dev/encoding#BinaryMarshaler
+func (s UUID) MarshalBinary() ([]byte, error) {
 	if s.IsNil() {
-		return nullBytes, nil
+		return marshalBinaryNil(s.isDefined), nil
 	}
 
-	jsonBytes, err := json.Marshal(s.underlying)
+	payload, err := s.underlying.MarshalBinary()
 	if err != nil {
-		return nil, errors.Wrap(err, s.String())
+		return nil, err
 	}
 
-	return jsonBytes, nil
+	return append([]byte{binaryDefined}, payload...), nil
 }
 
-// UnmarshalJSON implements the json Unmarshaler interface.
+// UnmarshalBinary implements the encoding BinaryUnmarshaler interface.
 //
-// See: https://pkg.go.dev/encoding/json#Unmarshaler
-func (s *UUID) UnmarshalJSON(d []byte) error {
-	s.isNil = isNullBytes(d)
-	s.isDefined = true
+// See: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (s *UUID) UnmarshalBinary(d []byte) error {
+	isDefined, isNil, payload, err := unmarshalBinaryState(d)
+	if err != nil {
+		return err
+	}
+
+	var underlying uuid.UUID
+	if isDefined && !isNil {
+		err := underlying.UnmarshalBinary(payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	*s = UUID{
+		underlying: underlying,
+		isDefined:  isDefined,
+		isNil:      isNil,
+	}
+
+	return nil
+}
+
+// EncodeMsgpack implements the msgpack CustomEncoder interface,
+// nil and undefined values are encoded as an extension type to keep the state.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomEncoder
+func (s UUID) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if s.IsNil() {
+		return encodeMsgpackNil(enc, s.isDefined)
+	}
+
+	return enc.EncodeBytes(s.underlying[:])
+}
+
+// DecodeMsgpack implements the msgpack CustomDecoder interface.
+//
+// See: https://pkg.go.dev/github.com/vmihailenco/msgpack/v5#CustomDecoder
+func (s *UUID) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isDefined, isNil, err := decodeMsgpackState(dec)
+	if err != nil {
+		return err
+	}
+
+	if !isDefined || isNil {
+		*s = UUID{
+			isDefined: isDefined,
+			isNil:     isNil,
+		}
 
-	if s.isNil {
 		return nil
 	}
 
-	err := json.Unmarshal(d, &s.underlying)
+	data, err := dec.DecodeBytes()
+	if err != nil {
+		return err
+	}
+
+	underlying, err := uuid.FromBytes(data)
 	if err != nil {
 		return err
 	}
 
+	*s = UUID{
+		underlying: underlying,
+		isDefined:  true,
+		isNil:      false,
+	}
+
 	return nil
 }
 
@@ -2619,6 +11887,389 @@ func (s UUID) UUIDValue() (pgtype.UUID, error) {
 	}, nil
 }
 
+// The array types are used to scan and store Postgres arrays, e.g. uuid[] or text[],
+// by parsing and formatting the Postgres array literal ("{a,b,c}").
+// Each element is scanned by the Scan method of the element type, NULL elements become nil values.
+// A NULL array is scanned as a nil slice, and a nil slice is stored as NULL.
+// Multi-dimensional arrays are not supported.
+
+// BoolArray is used to represent a Postgres array of Bool.
+type BoolArray []Bool
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (a *BoolArray) Scan(value interface{}) error {
+	array, err := scanArray[Bool](value)
+	if err != nil {
+		return err
+	}
+
+	*a = array
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (a BoolArray) Value() (driver.Value, error) {
+	return arrayValue(a)
+}
+
+// Float64Array is used to represent a Postgres array of Float64.
+type Float64Array []Float64
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (a *Float64Array) Scan(value interface{}) error {
+	array, err := scanArray[Float64](value)
+	if err != nil {
+		return err
+	}
+
+	*a = array
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (a Float64Array) Value() (driver.Value, error) {
+	return arrayValue(a)
+}
+
+// IntArray is used to represent a Postgres array of Int.
+type IntArray []Int
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (a *IntArray) Scan(value interface{}) error {
+	array, err := scanArray[Int](value)
+	if err != nil {
+		return err
+	}
+
+	*a = array
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (a IntArray) Value() (driver.Value, error) {
+	return arrayValue(a)
+}
+
+// IntSliceValue returns the Postgres array literal of the Ints, e.g. "{1,NULL,3}",
+// which can be used as a single query argument, e.g. "WHERE id = ANY($1)".
+//
+// Unlike IntArray, a nil slice returns an empty array instead of NULL.
+func IntSliceValue(ints []Int) (driver.Value, error) {
+	if ints == nil {
+		ints = []Int{}
+	}
+
+	return IntArray(ints).Value()
+}
+
+// Int16Array is used to represent a Postgres array of Int16.
+type Int16Array []Int16
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (a *Int16Array) Scan(value interface{}) error {
+	array, err := scanArray[Int16](value)
+	if err != nil {
+		return err
+	}
+
+	*a = array
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (a Int16Array) Value() (driver.Value, error) {
+	return arrayValue(a)
+}
+
+// Int64Array is used to represent a Postgres array of Int64.
+type Int64Array []Int64
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (a *Int64Array) Scan(value interface{}) error {
+	array, err := scanArray[Int64](value)
+	if err != nil {
+		return err
+	}
+
+	*a = array
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (a Int64Array) Value() (driver.Value, error) {
+	return arrayValue(a)
+}
+
+// StringArray is used to represent a Postgres array of String.
+type StringArray []String
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (a *StringArray) Scan(value interface{}) error {
+	array, err := scanArray[String](value)
+	if err != nil {
+		return err
+	}
+
+	*a = array
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (a StringArray) Value() (driver.Value, error) {
+	return arrayValue(a)
+}
+
+// UUIDArray is used to represent a Postgres array of UUID.
+type UUIDArray []UUID
+
+// Scan assigns a value from a database driver and implements the sql Scanner interface.
+//
+// See https://pkg.go.dev/database/sql#Scanner
+func (a *UUIDArray) Scan(value interface{}) error {
+	array, err := scanArray[UUID](value)
+	if err != nil {
+		return err
+	}
+
+	*a = array
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+//
+// See https://pkg.go.dev/database/sql/driver#Valuer
+func (a UUIDArray) Value() (driver.Value, error) {
+	return arrayValue(a)
+}
+
+// scanArray parses a Postgres array literal and scans each element into T.
+func scanArray[T any, PT interface {
+	*T
+	Scan(value interface{}) error
+}](value interface{}) ([]T, error) {
+	var literal string
+
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		literal = string(v)
+	case string:
+		literal = v
+	default:
+		return nil, errors.New(fmt.Sprintf("incompatible type for array: %T", value))
+	}
+
+	elements, err := parseArrayLiteral(literal)
+	if err != nil {
+		return nil, err
+	}
+
+	array := make([]T, len(elements))
+	for i := range elements {
+		var element interface{}
+		if elements[i] != nil {
+			element = *elements[i]
+		}
+
+		err := PT(&array[i]).Scan(element)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("cannot scan array element %d", i))
+		}
+	}
+
+	return array, nil
+}
+
+// parseArrayLiteral parses a one-dimensional Postgres array literal, e.g. {a,"b c",NULL},
+// into its elements where NULL elements are returned as nil.
+func parseArrayLiteral(literal string) ([]*string, error) {
+	invalid := errors.New("invalid array literal: " + literal)
+
+	literal = strings.TrimSpace(literal)
+	if len(literal) < 2 || literal[0] != '{' || literal[len(literal)-1] != '}' {
+		return nil, invalid
+	}
+
+	content := literal[1 : len(literal)-1]
+	elements := []*string{}
+
+	if strings.TrimSpace(content) == "" {
+		return elements, nil
+	}
+
+	for i := 0; i <= len(content); i++ {
+		var element strings.Builder
+		quoted := false
+
+		// Skip leading whitespace
+		for i < len(content) && content[i] == ' ' {
+			i++
+		}
+
+		if i < len(content) && content[i] == '{' {
+			return nil, errors.New("multi-dimensional arrays are not supported: " + literal)
+		}
+
+		if i < len(content) && content[i] == '"' {
+			quoted = true
+			i++
+
+			for ; i < len(content) && content[i] != '"'; i++ {
+				if content[i] == '\\' {
+					i++
+					if i == len(content) {
+						return nil, invalid
+					}
+				}
+
+				element.WriteByte(content[i])
+			}
+
+			if i == len(content) {
+				return nil, invalid
+			}
+
+			// Skip the closing quote and trailing whitespace
+			i++
+			for i < len(content) && content[i] == ' ' {
+				i++
+			}
+
+			if i < len(content) && content[i] != ',' {
+				return nil, invalid
+			}
+		} else {
+			for ; i < len(content) && content[i] != ','; i++ {
+				element.WriteByte(content[i])
+			}
+		}
+
+		str := element.String()
+		if !quoted {
+			str = strings.TrimSpace(str)
+
+			if str == "" {
+				return nil, invalid
+			}
+
+			if strings.EqualFold(str, "NULL") {
+				elements = append(elements, nil)
+				continue
+			}
+		}
+
+		elements = append(elements, &str)
+	}
+
+	return elements, nil
+}
+
+// arrayValue formats the elements as a Postgres array literal, nil elements become NULL.
+func arrayValue[T driver.Valuer](array []T) (driver.Value, error) {
+	if array == nil {
+		return nil, nil
+	}
+
+	var b strings.Builder
+
+	b.WriteByte('{')
+	for i := range array {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		value, err := array[i].Value()
+		if err != nil {
+			return nil, err
+		}
+
+		switch v := value.(type) {
+		case nil:
+			b.WriteString("NULL")
+		case bool:
+			b.WriteString(strconv.FormatBool(v))
+		case int64:
+			b.WriteString(strconv.FormatInt(v, 10))
+		case float64:
+			b.WriteString(strconv.FormatFloat(v, 'g', -1, 64))
+		case string:
+			b.WriteByte('"')
+			b.WriteString(strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(v))
+			b.WriteByte('"')
+		default:
+			return nil, errors.New(fmt.Sprintf("unsupported array element type: %T", value))
+		}
+	}
+	b.WriteByte('}')
+
+	return b.String(), nil
+}
+
+// parseIntClamped parses the string as an integer within the lower and upper bound,
+// out of range values are either clamped or returned as an error.
+func parseIntClamped(str string, lower, upper int64, clamp bool) (int64, error) {
+	// ParseInt returns the min or max int64 for out of range values, which is then clamped below
+	parsed, err := strconv.ParseInt(strings.TrimSpace(str), 10, 64)
+	if err != nil && !(clamp && errors.Is(err, strconv.ErrRange)) {
+		return 0, err
+	}
+
+	if parsed < lower || parsed > upper {
+		if !clamp {
+			return 0, errors.New(fmt.Sprintf("value %s is out of range [%d, %d]", strings.TrimSpace(str), lower, upper))
+		}
+
+		parsed = min(max(parsed, lower), upper)
+	}
+
+	return parsed, nil
+}
+
+// quoteTime formats the time as a JSON string without calling json.Marshal,
+// which is possible since the layouts used by the types never produce characters that need escaping.
+func quoteTime(t time.Time, layout string) []byte {
+	b := make([]byte, 0, len(layout)+2)
+	b = append(b, '"')
+	b = t.AppendFormat(b, layout)
+	return append(b, '"')
+}
+
+// must returns the value or panics if err is not nil, it is used by the Must functions.
+func must[T any](value T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
+// underlyingTime keeps the parts of the wall clock in the format and returns them in UTC,
+// e.g. the date of a Date or the clock of a Time, so the zone is dropped rather than converted.
 func underlyingTime(t time.Time, format string) time.Time {
 	t, _ = time.Parse(format, t.Format(format))
 	return t.UTC()
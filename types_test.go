@@ -1,118 +1,4932 @@
 package types
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/binary"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+	"gopkg.in/yaml.v3"
 )
 
+func TestAnonymize(t *testing.T) {
+	type address struct {
+		Street String `types:"pii"`
+		City   String
+	}
+
+	type person struct {
+		ID        UUID `types:"pii"`
+		OrgID     UUID
+		Name      String           `types:"pii"`
+		Nickname  String           `types:"pii"`
+		Email     String           `types:"pii"`
+		Bio       RichText         `types:"pii"`
+		Age       Int              `types:"pii"`
+		Phone     *String          `types:"pii"`
+		Mobile    *String          `types:"pii"`
+		Alias     Nullable[string] `types:"pii"`
+		Title     String
+		Address   address
+		Secondary *address
+	}
+
+	id := NewRandomUUID()
+	orgID := NewRandomUUID()
+
+	original := person{
+		ID:        id,
+		OrgID:     orgID,
+		Name:      NewString("Alice Andersson"),
+		Nickname:  NewStringFromPtr(nil),
+		Bio:       NewRichText("<p>Lives in <strong>Lund</strong></p>"),
+		Age:       NewInt(42),
+		Phone:     NewString("070-123 45 67").Ptr(),
+		Alias:     NewNullable("Ally"),
+		Title:     NewString("Teacher"),
+		Address:   address{Street: NewString("Storgatan 1"), City: NewString("Lund")},
+		Secondary: &address{Street: NewString("Lillgatan 2")},
+	}
+
+	anonymized := Anonymize(original)
+
+	t.Run("scrubs pii", func(t *testing.T) {
+		assert.NotEqual(t, original.Name, anonymized.Name)
+		assert.Contains(t, anonymized.Name.String(), "anonymized-")
+		assert.NotContains(t, anonymized.Bio.String(), "Lund")
+		assert.NotEqual(t, id, anonymized.ID)
+		assert.NotEqual(t, original.Address.Street, anonymized.Address.Street)
+		assert.Contains(t, anonymized.Phone.String(), "anonymized-")
+		assert.Equal(t, NewString("070-123 45 67"), *original.Phone)
+		assert.NotEqual(t, original.Alias, anonymized.Alias)
+		assert.Nil(t, anonymized.Mobile)
+	})
+
+	t.Run("keyed hash", func(t *testing.T) {
+		sum := sha256.Sum256([]byte("Alice Andersson"))
+		assert.NotEqual(t, "anonymized-"+hex.EncodeToString(sum[:4]), anonymized.Name.String())
+	})
+
+	t.Run("keeps other fields", func(t *testing.T) {
+		assert.Equal(t, orgID, anonymized.OrgID)
+		assert.Equal(t, NewInt(42), anonymized.Age)
+		assert.Equal(t, NewString("Teacher"), anonymized.Title)
+		assert.Equal(t, NewString("Lund"), anonymized.Address.City)
+		assert.Same(t, original.Secondary, anonymized.Secondary)
+		assert.Equal(t, NewString("Lillgatan 2"), anonymized.Secondary.Street)
+	})
+
+	t.Run("preserves state", func(t *testing.T) {
+		assert.True(t, anonymized.Nickname.IsDefined())
+		assert.True(t, anonymized.Nickname.IsNil())
+		assert.False(t, anonymized.Email.IsDefined())
+		assert.True(t, anonymized.Bio.IsDefined())
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		assert.Equal(t, anonymized, Anonymize(original))
+		assert.Equal(t, NewString("Alice Andersson"), original.Name)
+	})
+}
+
+func TestArray(t *testing.T) {
+	t.Run("UUIDArray", func(t *testing.T) {
+		var array UUIDArray
+		err := array.Scan([]byte(`{123e4567-e89b-12d3-a456-426614174000,NULL,"223e4567-e89b-12d3-a456-426614174000"}`))
+		require.NoError(t, err)
+
+		require.Len(t, array, 3)
+		assert.Equal(t, "123e4567-e89b-12d3-a456-426614174000", array[0].String())
+		assert.True(t, array[1].IsDefined())
+		assert.True(t, array[1].IsNil())
+		assert.Equal(t, "223e4567-e89b-12d3-a456-426614174000", array[2].String())
+
+		value, err := array.Value()
+		require.NoError(t, err)
+		assert.Equal(t, `{"123e4567-e89b-12d3-a456-426614174000",NULL,"223e4567-e89b-12d3-a456-426614174000"}`, value)
+	})
+
+	t.Run("StringArray", func(t *testing.T) {
+		var array StringArray
+		err := array.Scan(`{a,"b c","with \"quotes\" and \\ backslash",NULL,"NULL",""}`)
+		require.NoError(t, err)
+
+		require.Len(t, array, 6)
+		assert.Equal(t, "a", array[0].String())
+		assert.Equal(t, "b c", array[1].String())
+		assert.Equal(t, `with "quotes" and \ backslash`, array[2].String())
+		assert.True(t, array[3].IsNil())
+		assert.Equal(t, "NULL", array[4].String())
+		assert.False(t, array[5].IsNil())
+		assert.Equal(t, "", array[5].String())
+
+		value, err := array.Value()
+		require.NoError(t, err)
+		assert.Equal(t, `{"a","b c","with \"quotes\" and \\ backslash",NULL,"NULL",""}`, value)
+
+		var roundTrip StringArray
+		require.NoError(t, roundTrip.Scan(value))
+		assert.Equal(t, array, roundTrip)
+	})
+
+	t.Run("IntArray", func(t *testing.T) {
+		var array IntArray
+		err := array.Scan([]byte(`{1,-2,NULL}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, IntArray{NewInt(1), NewInt(-2), NewIntFromPtr(nil)}, array)
+
+		value, err := array.Value()
+		require.NoError(t, err)
+		assert.Equal(t, `{1,-2,NULL}`, value)
+	})
+
+	t.Run("BoolArray and Float64Array", func(t *testing.T) {
+		var bools BoolArray
+		require.NoError(t, bools.Scan(`{t,f}`))
+		assert.Equal(t, BoolArray{NewBool(true), NewBool(false)}, bools)
+
+		var floats Float64Array
+		require.NoError(t, floats.Scan(`{1.5,2}`))
+		assert.Equal(t, Float64Array{NewFloat64(1.5), NewFloat64(2)}, floats)
+
+		value, err := floats.Value()
+		require.NoError(t, err)
+		assert.Equal(t, `{1.5,2}`, value)
+	})
+
+	t.Run("Empty and NULL arrays", func(t *testing.T) {
+		var array Int64Array
+		require.NoError(t, array.Scan(`{}`))
+		assert.NotNil(t, array)
+		assert.Len(t, array, 0)
+
+		value, err := array.Value()
+		require.NoError(t, err)
+		assert.Equal(t, `{}`, value)
+
+		require.NoError(t, array.Scan(nil))
+		assert.Nil(t, array)
+
+		value, err = array.Value()
+		require.NoError(t, err)
+		assert.Nil(t, value)
+	})
+
+	t.Run("Invalid arrays", func(t *testing.T) {
+		for _, literal := range []string{`1,2`, `{1,}`, `{"a}`, `{{1,2},{3,4}}`, `{a}`} {
+			var array Int16Array
+			assert.Error(t, array.Scan(literal), literal)
+		}
+	})
+
+	t.Run("IntSliceValue", func(t *testing.T) {
+		tt := []struct {
+			name     string
+			input    []Int
+			expected driver.Value
+		}{
+			{"nil", nil, "{}"},
+			{"empty", []Int{}, "{}"},
+			{"values", []Int{NewInt(1), NewInt(2), NewInt(3)}, "{1,2,3}"},
+			{"mixed nil", []Int{NewInt(1), NewIntFromPtr(nil), NewIntUndefined(), NewInt(-4)}, "{1,NULL,NULL,-4}"},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				value, err := IntSliceValue(tc.input)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, value)
+			})
+		}
+	})
+}
+
+func TestBinary(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		now := time.Date(2024, 3, 5, 14, 30, 15, 123, time.UTC)
+
+		tt := []struct {
+			name   string
+			value  encoding.BinaryMarshaler
+			target encoding.BinaryUnmarshaler
+		}{
+			{"bool defined", NewBool(true), &Bool{}},
+			{"bool nil", NewBoolFromPtr(nil), &Bool{}},
+			{"bool undefined", NewBoolUndefined(), &Bool{}},
+			{"date defined", NewDate(now), &Date{}},
+			{"date time defined", NewDateTime(time.Date(2024, 1, 2, 15, 4, 5, 123456789, time.FixedZone("", 2*60*60))), &DateTime{}},
+			{"date time nil", NewDateTimeFromPtr(nil), &DateTime{}},
+			{"float64 defined", NewFloat64(-12.75), &Float64{}},
+			{"float64 nil", NewFloat64FromPtr(nil), &Float64{}},
+			{"int defined", NewInt(-42), &Int{}},
+			{"int16 defined", NewInt16(-1234), &Int16{}},
+			{"int64 defined", NewInt64(1 << 40), &Int64{}},
+			{"int64 undefined", NewInt64Undefined(), &Int64{}},
+			{"json defined", NewJSON(json.RawMessage(`{"a":1}`)), &JSON{}},
+			{"money defined", NewMoney(-1250, "SEK"), &Money{}},
+			{"money nil", NewMoneyFromPtr(nil, ""), &Money{}},
+			{"percent defined", NewPercent(42.5), &Percent{}},
+			{"rich text defined", NewRichText("<p>hej</p>"), &RichText{}},
+			{"string defined", NewString("hello"), &String{}},
+			{"string empty", NewString(""), &String{}},
+			{"string nil", NewStringFromPtr(nil), &String{}},
+			{"string undefined", NewStringUndefined(), &String{}},
+			{"time defined", NewTime(now), &Time{}},
+			{"timestamp defined", NewTimestamp(now), &Timestamp{}},
+			{"timestamp nil", NewTimestampFromPtr(nil), &Timestamp{}},
+			{"uuid defined", NewUUID(uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")), &UUID{}},
+			{"uuid undefined", NewUUIDUndefined(), &UUID{}},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				data, err := tc.value.MarshalBinary()
+				require.NoError(t, err)
+
+				err = tc.target.UnmarshalBinary(data)
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.value, reflect.ValueOf(tc.target).Elem().Interface())
+			})
+		}
+	})
+
+	t.Run("state byte", func(t *testing.T) {
+		data, err := NewStringUndefined().MarshalBinary()
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0}, data)
+
+		data, err = NewStringFromPtr(nil).MarshalBinary()
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1}, data)
+
+		data, err = NewString("ab").MarshalBinary()
+		require.NoError(t, err)
+		assert.Equal(t, []byte{2, 'a', 'b'}, data)
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		var i Int
+		assert.Error(t, i.UnmarshalBinary(nil))
+		assert.Error(t, i.UnmarshalBinary([]byte{3}))
+		assert.Error(t, i.UnmarshalBinary([]byte{1, 0}))
+		assert.Error(t, i.UnmarshalBinary([]byte{2, 0, 1}))
+
+		var u UUID
+		assert.Error(t, u.UnmarshalBinary([]byte{2, 1, 2, 3}))
+	})
+}
+
+func TestBool(t *testing.T) {
+	t.Run("BoolText round trip", func(t *testing.T) {
+		tt := []struct {
+			name     string
+			input    BoolText
+			expected driver.Value
+		}{
+			{"true", NewBoolTextMode(NewBool(true)), "true"},
+			{"false", NewBoolTextMode(NewBool(false)), "false"},
+			{"nil", NewBoolTextMode(NewBoolFromPtr(nil)), nil},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				value, err := tc.input.Value()
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, value)
+
+				var scanned BoolText
+				require.NoError(t, scanned.Scan(value))
+				assert.Equal(t, tc.input, scanned)
+			})
+		}
+	})
+
+	t.Run("BoolText scan", func(t *testing.T) {
+		var b BoolText
+		require.NoError(t, b.Scan([]byte("true ")))
+		assert.True(t, b.Bool.Bool())
+
+		require.NoError(t, b.Scan("false"))
+		assert.False(t, b.Bool.Bool())
+		assert.False(t, b.IsNil())
+
+		assert.Error(t, b.Scan("yes"))
+	})
+
+	t.Run("BoolText JSON", func(t *testing.T) {
+		data, err := json.Marshal(NewBoolTextMode(NewBool(true)))
+		require.NoError(t, err)
+		assert.Equal(t, "true", string(data))
+	})
+}
+
+func TestBytes(t *testing.T) {
+	t.Run("BytesFromString", func(t *testing.T) {
+		b, err := BytesFromString("aGVsbG8=")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("hello"), b.Bytes())
+		assert.Equal(t, "aGVsbG8=", b.String())
+
+		b, err = BytesFromString("")
+		require.NoError(t, err)
+		assert.True(t, b.IsNil())
+
+		_, err = BytesFromString("not base64!")
+		assert.Error(t, err)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		tt := []struct {
+			name     string
+			input    Bytes
+			expected string
+		}{
+			{"value", NewBytes([]byte{0xff, 0x00, 0x10}), `"/wAQ"`},
+			{"empty", NewBytes([]byte{}), `""`},
+			{"nil", NewBytesFromPtr(nil), `null`},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				data, err := json.Marshal(tc.input)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, string(data))
+
+				var b Bytes
+				require.NoError(t, json.Unmarshal(data, &b))
+				assert.Equal(t, tc.input.IsNil(), b.IsNil())
+				assert.Equal(t, tc.input.Bytes(), b.Bytes())
+			})
+		}
+	})
+
+	t.Run("Scan and Value", func(t *testing.T) {
+		src := []byte("signature")
+
+		var b Bytes
+		require.NoError(t, b.Scan(src))
+		src[0] = 'S'
+		assert.Equal(t, []byte("signature"), b.Bytes())
+
+		value, err := b.Value()
+		require.NoError(t, err)
+		assert.Equal(t, []byte("signature"), value)
+
+		require.NoError(t, b.Scan([]byte{}))
+		assert.False(t, b.IsNil())
+		value, err = b.Value()
+		require.NoError(t, err)
+		assert.Equal(t, []byte{}, value)
+
+		require.NoError(t, b.Scan(nil))
+		assert.True(t, b.IsNil())
+		value, err = b.Value()
+		require.NoError(t, err)
+		assert.Nil(t, value)
+
+		assert.Error(t, b.Scan(42))
+	})
+
+	t.Run("ParseFromString and IsEmptyArray", func(t *testing.T) {
+		value, err := ParseFromString("types.Bytes", "aGVsbG8=")
+		require.NoError(t, err)
+		assert.Equal(t, NewBytes([]byte("hello")), value)
+
+		assert.True(t, IsEmptyArray([]Bytes{}))
+		assert.False(t, IsEmptyArray([]Bytes{NewBytes(nil)}))
+	})
+}
+
+func TestCacheKey(t *testing.T) {
+	schoolID := NewUUID(uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))
+
+	t.Run("deterministic", func(t *testing.T) {
+		assert.Equal(t, CacheKey(schoolID, NewInt(1)), CacheKey(schoolID, NewInt(1)))
+		assert.Equal(t, `"types.Int"=nil,"types.String"=undefined`, CacheKey(NewIntFromPtr(nil), NewStringUndefined()))
+	})
+
+	t.Run("different values give different keys", func(t *testing.T) {
+		keys := []string{
+			CacheKey(),
+			CacheKey(NewInt(0)),
+			CacheKey(NewIntFromPtr(nil)),
+			CacheKey(NewIntUndefined()),
+			CacheKey(NewInt(1)),
+			CacheKey(NewString("1")),
+			CacheKey(NewString("")),
+			CacheKey(NewStringFromPtr(nil)),
+			CacheKey(NewString("a"), NewString("b")),
+			CacheKey(NewString("a,b")),
+			CacheKey(NewString(`a"=nil,"types.String"="b`)),
+			CacheKey(NewString("b"), NewString("a")),
+			CacheKey(NewFloat64(1.001)),
+			CacheKey(NewFloat64(1.002)),
+			CacheKey(schoolID, NewInt(1)),
+			CacheKey(schoolID, NewInt(2)),
+			CacheKey(NewNullable(uint8(1))),
+		}
+
+		seen := map[string]int{}
+		for i, key := range keys {
+			if j, ok := seen[key]; ok {
+				t.Errorf("key %d equals key %d: %s", i, j, key)
+			}
+			seen[key] = i
+		}
+	})
+
+	t.Run("pointers", func(t *testing.T) {
+		var name *String
+
+		assert.Equal(t, CacheKey(NewStringUndefined()), CacheKey(name))
+		assert.Equal(t, CacheKey(NewString("Alice")), CacheKey(NewString("Alice").Ptr()))
+	})
+}
+
+func TestCoalesce(t *testing.T) {
+	t.Run("first defined and not nil", func(t *testing.T) {
+		override := NewStringUndefined()
+		stored := NewStringFromPtr(nil)
+
+		assert.Equal(t, NewString("default"), Coalesce(override, stored, NewString("default")))
+		assert.Equal(t, NewInt(0), Coalesce(NewIntFromPtr(nil), NewInt(0), NewInt(1)))
+	})
+
+	t.Run("no value qualifies", func(t *testing.T) {
+		s := Coalesce(NewStringUndefined(), NewStringFromPtr(nil))
+		assert.True(t, s.IsDefined())
+		assert.True(t, s.IsNil())
+
+		ts := Coalesce[Timestamp]()
+		assert.True(t, ts.IsDefined())
+		assert.True(t, ts.IsNil())
+
+		n := Coalesce(NewNullableUndefined[int]())
+		assert.True(t, n.IsDefined())
+		assert.True(t, n.IsNil())
+	})
+}
+
+func TestCSV(t *testing.T) {
+	type row struct {
+		ID      int
+		Name    String
+		Age     Int
+		Started Date
+		note    string
+	}
+
+	r := row{
+		ID:      1,
+		Name:    NewString("Alice"),
+		Age:     NewIntFromPtr(nil),
+		Started: NewDateUndefined(),
+		note:    "ignored",
+	}
+
+	tt := []struct {
+		name     string
+		options  CSVOptions
+		expected []string
+	}{
+		{"empty", CSVOptions{}, []string{"1", "Alice", "", ""}},
+		{"NULL", CSVOptions{NullToken: "NULL"}, []string{"1", "Alice", "NULL", "NULL"}},
+		{"postgres", CSVOptions{NullToken: `\N`}, []string{"1", "Alice", `\N`, `\N`}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			record, err := MarshalCSVRecord(&r, tc.options)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, record)
+		})
+	}
+
+	t.Run("not a struct", func(t *testing.T) {
+		_, err := MarshalCSVRecord("row", CSVOptions{})
+		assert.Error(t, err)
+	})
+
+	t.Run("CSVValue", func(t *testing.T) {
+		SetFloat64DecimalSeparator(",")
+		t.Cleanup(func() { SetFloat64DecimalSeparator(".") })
+
+		assert.Equal(t, "12,5", NewFloat64(12.5).String())
+		assert.Equal(t, "12.5", NewFloat64(12.5).CSVValue())
+		assert.Equal(t, "", NewFloat64FromPtr(nil).CSVValue())
+		assert.Equal(t, "true", NewBool(true).CSVValue())
+		assert.Equal(t, "2024-01-02", NewDate(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)).CSVValue())
+		assert.Equal(t, "", NewUUIDUndefined().CSVValue())
+		assert.Equal(t, "42.5%", NewPercent(42.5).CSVValue())
+
+		values := []CSVValuer{
+			Bool{}, Bytes{}, Color{}, Date{}, DateTime{}, Float64{}, Int{}, Int16{}, Int64{}, JSON{}, Money{},
+			Percent{}, RichText{}, String{}, Time{}, TimeSeconds{}, Timestamp{}, UUID{},
+		}
+
+		for _, v := range values {
+			assert.Equal(t, "", v.CSVValue())
+		}
+
+		record, err := MarshalCSVRecord(struct {
+			Amount Float64
+			Total  Float64
+		}{NewFloat64(1.25), NewFloat64FromPtr(nil)}, CSVOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1.25", ""}, record)
+	})
+
+	t.Run("Float64Precision", func(t *testing.T) {
+		row := struct {
+			Amount Float64
+			Total  Float64
+		}{NewFloat64(1.23456), NewFloat64FromPtr(nil)}
+
+		assert.Equal(t, "1.23456", row.Amount.CSVValue())
+
+		record, err := MarshalCSVRecord(row, CSVOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1.23456", ""}, record)
+
+		precision := 2
+		record, err = MarshalCSVRecord(row, CSVOptions{Float64Precision: &precision})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1.23", ""}, record)
+	})
+
+	t.Run("pointer fields", func(t *testing.T) {
+		record, err := MarshalCSVRecord(struct {
+			Name     *String
+			Nickname *String
+			Age      *Int
+		}{NewString("Alice").Ptr(), nil, NewIntFromPtr(nil).Ptr()}, CSVOptions{NullToken: "NULL"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Alice", "NULL", "NULL"}, record)
+	})
+}
+
+func TestColor(t *testing.T) {
+	t.Run("ColorFromString", func(t *testing.T) {
+		tt := []struct {
+			input    string
+			expected string
+			wantErr  bool
+		}{
+			{input: "#FFAA00", expected: "#ffaa00"},
+			{input: "#fa0", expected: "#ffaa00"},
+			{input: " #ffaa0080 ", expected: "#ffaa0080"},
+			{input: "ffaa00", wantErr: true},
+			{input: "#ffaa0", wantErr: true},
+			{input: "#ggaa00", wantErr: true},
+			{input: "#ffaa00800", wantErr: true},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.input, func(t *testing.T) {
+				c, err := ColorFromString(tc.input)
+				if tc.wantErr {
+					assert.Error(t, err)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, c.String())
+			})
+		}
+
+		c, err := ColorFromString("")
+		require.NoError(t, err)
+		assert.True(t, c.IsNil())
+	})
+
+	t.Run("RGBA", func(t *testing.T) {
+		r, g, b, a := NewColor("#FA0").RGBA()
+		assert.Equal(t, []uint8{255, 170, 0, 255}, []uint8{r, g, b, a})
+
+		r, g, b, a = NewColor("#01020380").RGBA()
+		assert.Equal(t, []uint8{1, 2, 3, 128}, []uint8{r, g, b, a})
+
+		r, g, b, a = NewColorFromPtr(nil).RGBA()
+		assert.Equal(t, []uint8{0, 0, 0, 0}, []uint8{r, g, b, a})
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		var theme struct {
+			Primary   Color
+			Secondary Color
+			Accent    Color
+		}
+
+		require.NoError(t, json.Unmarshal([]byte(`{"Primary":"#ABC","Secondary":null}`), &theme))
+		assert.Equal(t, "#aabbcc", theme.Primary.String())
+		assert.True(t, theme.Secondary.IsDefined())
+		assert.True(t, theme.Secondary.IsNil())
+		assert.False(t, theme.Accent.IsDefined())
+
+		data, err := json.Marshal(theme)
+		require.NoError(t, err)
+		assert.Equal(t, `{"Primary":"#aabbcc","Secondary":null,"Accent":null}`, string(data))
+
+		assert.Error(t, json.Unmarshal([]byte(`{"Primary":"red"}`), &theme))
+	})
+
+	t.Run("Scan and Value", func(t *testing.T) {
+		var c Color
+		require.NoError(t, c.Scan([]byte("#ABCDEF")))
+		assert.Equal(t, "#abcdef", c.String())
+
+		value, err := c.Value()
+		require.NoError(t, err)
+		assert.Equal(t, "#abcdef", value)
+
+		require.NoError(t, c.Scan(nil))
+		assert.True(t, c.IsNil())
+
+		assert.Error(t, c.Scan("blue"))
+	})
+
+	t.Run("ParseFromString and IsEmptyArray", func(t *testing.T) {
+		value, err := ParseFromString("types.Color", "#abc")
+		require.NoError(t, err)
+		assert.Equal(t, NewColor("#aabbcc"), value)
+
+		assert.True(t, IsEmptyArray([]Color{}))
+	})
+
+	t.Run("every entry path is normalized", func(t *testing.T) {
+		assert.Equal(t, "#aabbcc", NewColor(" #ABC ").String())
+		assert.Equal(t, "red", NewColor("RED").String())
+		assert.Panics(t, func() { MustColorFromString("red") })
+
+		var c Color
+		require.NoError(t, c.UnmarshalBinary(append([]byte{binaryDefined}, "#ABC"...)))
+		assert.Equal(t, NewColor("#aabbcc"), c)
+		assert.Error(t, c.UnmarshalBinary(append([]byte{binaryDefined}, "red"...)))
+
+		data, err := msgpack.Marshal("#ABC")
+		require.NoError(t, err)
+		require.NoError(t, msgpack.Unmarshal(data, &c))
+		assert.Equal(t, NewColor("#aabbcc"), c)
+
+		data, err = msgpack.Marshal("red")
+		require.NoError(t, err)
+		assert.Error(t, msgpack.Unmarshal(data, &c))
+	})
+}
+
+func TestDate(t *testing.T) {
+	t.Run("DatesBetween", func(t *testing.T) {
+		tt := []struct {
+			name, start, end string
+			expected         []string
+		}{
+			{name: "single day", start: "2024-03-10", end: "2024-03-10", expected: []string{"2024-03-10"}},
+			{name: "multi month", start: "2024-01-30", end: "2024-03-02", expected: []string{
+				"2024-01-30", "2024-01-31",
+				"2024-02-01", "2024-02-02", "2024-02-03", "2024-02-04", "2024-02-05", "2024-02-06", "2024-02-07",
+				"2024-02-08", "2024-02-09", "2024-02-10", "2024-02-11", "2024-02-12", "2024-02-13", "2024-02-14",
+				"2024-02-15", "2024-02-16", "2024-02-17", "2024-02-18", "2024-02-19", "2024-02-20", "2024-02-21",
+				"2024-02-22", "2024-02-23", "2024-02-24", "2024-02-25", "2024-02-26", "2024-02-27", "2024-02-28",
+				"2024-02-29",
+				"2024-03-01", "2024-03-02",
+			}},
+			{name: "start after end", start: "2024-03-10", end: "2024-03-09", expected: []string{}},
+			{name: "nil start", start: "", end: "2024-03-09", expected: []string{}},
+			{name: "nil end", start: "2024-03-10", end: "", expected: []string{}},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				start, err := DateFromString(tc.start)
+				require.NoError(t, err)
+
+				end, err := DateFromString(tc.end)
+				require.NoError(t, err)
+
+				dates := DatesBetween(start, end)
+
+				actual := make([]string, len(dates))
+				for i := range dates {
+					actual[i] = dates[i].String()
+				}
+
+				assert.Equal(t, tc.expected, actual)
+			})
+		}
+	})
+
+	t.Run("SameMonthDay", func(t *testing.T) {
+		tt := []struct {
+			a, b     string
+			expected bool
+		}{
+			{a: "1990-06-15", b: "2024-06-15", expected: true},
+			{a: "1990-06-15", b: "2024-06-16", expected: false},
+			{a: "1990-06-15", b: "1990-07-15", expected: false},
+			{a: "2020-02-29", b: "2024-02-29", expected: true},
+			{a: "2020-02-29", b: "2023-02-28", expected: false},
+			{a: "2020-02-29", b: "2023-03-01", expected: false},
+			{a: "", b: "2024-06-15", expected: false},
+			{a: "2024-06-15", b: "", expected: false},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.a+" "+tc.b, func(t *testing.T) {
+				a, err := DateFromString(tc.a)
+				require.NoError(t, err)
+
+				b, err := DateFromString(tc.b)
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expected, a.SameMonthDay(b))
+			})
+		}
+
+		assert.False(t, NewDateUndefined().SameMonthDay(NewDateUndefined()))
+	})
+
+	t.Run("AddDate", func(t *testing.T) {
+		enrolled := NewDate(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC))
+
+		assert.Equal(t, "2024-03-01", enrolled.AddDate(0, 0, 30).String())
+		assert.Equal(t, "2024-03-02", enrolled.AddDate(0, 1, 0).String())
+		assert.Equal(t, "2023-01-31", enrolled.AddDate(-1, 0, 0).String())
+
+		assert.True(t, NewDateFromPtr(nil).AddDate(0, 0, 1).IsNil())
+		assert.True(t, NewDateFromPtr(nil).AddDate(0, 0, 1).IsDefined())
+		assert.False(t, NewDateUndefined().AddDate(0, 0, 1).IsDefined())
+	})
+
+	t.Run("DaysBetween", func(t *testing.T) {
+		from := NewDate(time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC))
+		to := NewDate(time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC))
+
+		assert.Equal(t, 4, from.DaysBetween(to))
+		assert.Equal(t, -4, to.DaysBetween(from))
+		assert.Equal(t, 0, from.DaysBetween(from))
+		assert.Equal(t, 0, from.DaysBetween(NewDateFromPtr(nil)))
+
+		// Spans beyond the ~292 year range of time.Duration must not saturate.
+		first := NewDate(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+		last := NewDate(time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC))
+		assert.Equal(t, 2921939, first.DaysBetween(last))
+		assert.Equal(t, -2921939, last.DaysBetween(first))
+		assert.Equal(t, 3652058, NewDate(time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)).DaysBetween(last))
+	})
+
+	t.Run("Before, After and Equal", func(t *testing.T) {
+		a := NewDate(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC))
+		b := NewDate(time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC))
+		null := NewDateFromPtr(nil)
+
+		assert.True(t, a.Before(b))
+		assert.False(t, b.Before(a))
+		assert.True(t, b.After(a))
+		assert.False(t, a.After(b))
+		assert.True(t, a.Equal(NewDate(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC))))
+		assert.False(t, a.Equal(b))
+
+		assert.False(t, a.Before(null))
+		assert.False(t, null.After(a))
+		assert.False(t, a.Equal(null))
+		assert.True(t, null.Equal(NewDateUndefined()))
+	})
+
+	t.Run("NotInFuture", func(t *testing.T) {
+		stockholm, err := time.LoadLocation("Europe/Stockholm")
+		require.NoError(t, err)
+
+		// 23:30 UTC is already the next day in Stockholm
+		now := time.Date(2024, 6, 1, 23, 30, 0, 0, time.UTC)
+		SetNow(func() time.Time { return now })
+		t.Cleanup(func() { SetNow(nil) })
+
+		assert.NoError(t, NewDate(time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)).NotInFuture(time.UTC))
+		assert.NoError(t, NewDate(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)).NotInFuture(time.UTC))
+		assert.EqualError(t, NewDate(time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)).NotInFuture(time.UTC), "date 2024-06-02 is in the future, today is 2024-06-01")
+		assert.NoError(t, NewDate(time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)).NotInFuture(stockholm))
+		assert.NoError(t, NewDateFromPtr(nil).NotInFuture(time.UTC))
+	})
+
+	t.Run("Scan normalizes the time", func(t *testing.T) {
+		var d Date
+		require.NoError(t, d.Scan(time.Date(2024, 3, 10, 22, 15, 0, 0, time.FixedZone("", -5*60*60))))
+
+		assert.Equal(t, "2024-03-10", d.String())
+		assert.Equal(t, time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC), d.Date())
+		assert.Equal(t, NewDate(time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)), d)
+	})
+
+	t.Run("ordinal dates", func(t *testing.T) {
+		tt := []struct {
+			input    string
+			expected string
+			wantErr  bool
+		}{
+			{input: "2024-001", expected: "2024-01-01"},
+			{input: "2024-060", expected: "2024-02-29"},
+			{input: "2024-366", expected: "2024-12-31"},
+			{input: "2023-365", expected: "2023-12-31"},
+			{input: "2023-366", wantErr: true},
+			{input: "2023-000", wantErr: true},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.input, func(t *testing.T) {
+				d, err := DateFromString(tc.input)
+				if tc.wantErr {
+					assert.Error(t, err)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, d.String())
+			})
+		}
+	})
+
+	t.Run("DateFromOrdinal", func(t *testing.T) {
+		d, err := DateFromOrdinal(2024, 1)
+		require.NoError(t, err)
+		assert.Equal(t, "2024-01-01", d.String())
+
+		d, err = DateFromOrdinal(2024, 366)
+		require.NoError(t, err)
+		assert.Equal(t, "2024-12-31", d.String())
+
+		_, err = DateFromOrdinal(2023, 366)
+		assert.EqualError(t, err, "day of year out of range for 2023: 366")
+
+		_, err = DateFromOrdinal(2023, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("compact dates", func(t *testing.T) {
+		tt := []struct {
+			input    string
+			expected string
+			wantErr  bool
+		}{
+			{input: "20240102", expected: "2024-01-02"},
+			{input: "240102", expected: "2024-01-02"},
+			{input: "991231", expected: "1999-12-31"},
+			{input: "20241301", wantErr: true},
+			{input: "2024012", wantErr: true},
+			{input: "24010", wantErr: true},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.input, func(t *testing.T) {
+				d, err := DateFromString(tc.input)
+				if tc.wantErr {
+					assert.Error(t, err)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, d.String())
+			})
+		}
+	})
+
+	t.Run("MinDate and MaxDate", func(t *testing.T) {
+		first := NewDate(time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC))
+		last := NewDate(time.Date(2024, 6, 14, 0, 0, 0, 0, time.UTC))
+
+		values := []Date{NewDateUndefined(), last, first, NewDateFromPtr(nil)}
+		assert.Equal(t, first, MinDate(values))
+		assert.Equal(t, last, MaxDate(values))
+		assert.Equal(t, first, MaxDate([]Date{first}))
+		assert.True(t, MinDate([]Date{NewDateFromPtr(nil)}).IsNil())
+		assert.True(t, MaxDate(nil).IsNil())
+
+		middle := NewDate(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+		assert.Equal(t, middle, middle.Clamp(first, last))
+		assert.Equal(t, first, NewDate(time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)).Clamp(first, last))
+		assert.Equal(t, last, NewDate(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)).Clamp(first, last))
+	})
+
+	t.Run("DateFromStringWithLayouts", func(t *testing.T) {
+		d, err := DateFromString("03-04-05")
+		require.NoError(t, err)
+		assert.Equal(t, "2005-03-04", d.String())
+
+		d, err = DateFromString("13-04-05")
+		require.NoError(t, err)
+		assert.Equal(t, "2005-04-13", d.String())
+
+		european := []string{"2006-01-02", "02-01-06", "02-01-2006"}
+
+		d, err = DateFromStringWithLayouts("03-04-05", european)
+		require.NoError(t, err)
+		assert.Equal(t, "2005-04-03", d.String())
+
+		d, err = DateFromStringWithLayouts("03-04-2005", european)
+		require.NoError(t, err)
+		assert.Equal(t, "2005-04-03", d.String())
+
+		d, err = DateFromStringWithLayouts("2005-04-03", european)
+		require.NoError(t, err)
+		assert.Equal(t, "2005-04-03", d.String())
+
+		_, err = DateFromStringWithLayouts("04-13-05", european)
+		assert.EqualError(t, err, "invalid date format: 04-13-05")
+
+		_, err = DateFromStringWithLayouts("2005-04-03", nil)
+		assert.Error(t, err)
+
+		d, err = DateFromStringWithLayouts("", european)
+		require.NoError(t, err)
+		assert.True(t, d.IsNil())
+	})
+}
+
+func TestDateRange(t *testing.T) {
+	date := func(month, day int) Date {
+		return NewDate(time.Date(2024, time.Month(month), day, 0, 0, 0, 0, time.UTC))
+	}
+
+	nilDate := NewDateFromPtr(nil)
+	spring := NewDateRange(date(1, 8), date(6, 14))
+
+	t.Run("Contains", func(t *testing.T) {
+		tt := []struct {
+			name     string
+			r        DateRange
+			date     Date
+			expected bool
+		}{
+			{name: "inside", r: spring, date: date(3, 1), expected: true},
+			{name: "start", r: spring, date: date(1, 8), expected: true},
+			{name: "inclusive end", r: spring, date: date(6, 14), expected: true},
+			{name: "exclusive end", r: DateRange{Start: date(1, 8), End: date(6, 14), ExclusiveEnd: true}, date: date(6, 14), expected: false},
+			{name: "day before exclusive end", r: DateRange{Start: date(1, 8), End: date(6, 14), ExclusiveEnd: true}, date: date(6, 13), expected: true},
+			{name: "before", r: spring, date: date(1, 7), expected: false},
+			{name: "after", r: spring, date: date(6, 15), expected: false},
+			{name: "unbounded past", r: NewDateRange(nilDate, date(6, 14)), date: NewDate(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)), expected: true},
+			{name: "unbounded future", r: NewDateRange(date(1, 8), nilDate), date: NewDate(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)), expected: true},
+			{name: "unbounded", r: DateRange{}, date: date(1, 1), expected: true},
+			{name: "nil date", r: spring, date: nilDate, expected: false},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				assert.Equal(t, tc.expected, tc.r.Contains(tc.date))
+			})
+		}
+	})
+
+	t.Run("Overlaps", func(t *testing.T) {
+		tt := []struct {
+			name     string
+			a, b     DateRange
+			expected bool
+		}{
+			{name: "overlapping", a: spring, b: NewDateRange(date(6, 1), date(8, 31)), expected: true},
+			{name: "touching inclusive", a: spring, b: NewDateRange(date(6, 14), date(8, 31)), expected: true},
+			{name: "touching exclusive", a: DateRange{Start: date(1, 8), End: date(6, 14), ExclusiveEnd: true}, b: NewDateRange(date(6, 14), date(8, 31)), expected: false},
+			{name: "disjoint", a: spring, b: NewDateRange(date(8, 19), date(12, 20)), expected: false},
+			{name: "contained", a: spring, b: NewDateRange(date(2, 1), date(2, 2)), expected: true},
+			{name: "unbounded past", a: NewDateRange(nilDate, date(1, 8)), b: spring, expected: true},
+			{name: "unbounded future", a: NewDateRange(date(6, 15), nilDate), b: spring, expected: false},
+			{name: "both unbounded", a: DateRange{}, b: DateRange{}, expected: true},
+			{name: "empty", a: NewDateRange(date(3, 2), date(3, 1)), b: spring, expected: false},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				assert.Equal(t, tc.expected, tc.a.Overlaps(tc.b))
+				assert.Equal(t, tc.expected, tc.b.Overlaps(tc.a))
+			})
+		}
+	})
+
+	t.Run("Days", func(t *testing.T) {
+		assert.Equal(t, 1, NewDateRange(date(3, 1), date(3, 1)).Days())
+		assert.Equal(t, 31, NewDateRange(date(3, 1), date(3, 31)).Days())
+		assert.Equal(t, 30, DateRange{Start: date(3, 1), End: date(3, 31), ExclusiveEnd: true}.Days())
+		assert.Equal(t, 29, NewDateRange(date(2, 1), date(2, 29)).Days())
+		assert.Equal(t, 0, NewDateRange(date(3, 2), date(3, 1)).Days())
+		assert.Equal(t, 2921940, NewDateRange(
+			NewDate(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)),
+			NewDate(time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)),
+		).Days())
+		assert.Equal(t, -1, NewDateRange(nilDate, date(3, 1)).Days())
+		assert.Equal(t, -1, NewDateRange(date(3, 1), NewDateUndefined()).Days())
+	})
+}
+
+func TestDateTime(t *testing.T) {
+	zone := time.FixedZone("", 2*60*60)
+	instant := time.Date(2023, 6, 1, 23, 30, 0, 123456789, zone)
+
+	t.Run("keeps zone and nanoseconds", func(t *testing.T) {
+		dt := NewDateTime(instant)
+		assert.Equal(t, "2023-06-01T23:30:00.123456789+02:00", dt.String())
+		assert.Equal(t, instant, dt.DateTime())
+		assert.Equal(t, "2023-06-01T21:30:00Z", dt.Timestamp().String())
+	})
+
+	t.Run("DateTimeFromString", func(t *testing.T) {
+		tt := []struct {
+			input    string
+			expected string
+		}{
+			{"2023-06-01T23:30:00.123456789+02:00", "2023-06-01T23:30:00.123456789+02:00"},
+			{"2023-06-01T23:30:00+02:00", "2023-06-01T23:30:00+02:00"},
+			{"2023-06-01 23:30:00.5Z", "2023-06-01T23:30:00.5Z"},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.input, func(t *testing.T) {
+				dt, err := DateTimeFromString(tc.input)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, dt.String())
+			})
+		}
+
+		_, err := DateTimeFromString("2023-06-01T23:30:00")
+		assert.Error(t, err)
+
+		dt, err := DateTimeFromString("")
+		require.NoError(t, err)
+		assert.True(t, dt.IsDefined())
+		assert.True(t, dt.IsNil())
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		type entry struct {
+			At        DateTime `json:"at"`
+			RemovedAt DateTime `json:"removed_at"`
+			Reviewed  DateTime `json:"reviewed"`
+		}
+
+		data, err := json.Marshal(entry{At: NewDateTime(instant), RemovedAt: NewDateTimeFromPtr(nil)})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"at":"2023-06-01T23:30:00.123456789+02:00","removed_at":null,"reviewed":null}`, string(data))
+
+		var e entry
+		require.NoError(t, json.Unmarshal([]byte(`{"at":"2023-06-01T23:30:00.123456789+02:00","removed_at":null}`), &e))
+		assert.True(t, instant.Equal(e.At.DateTime()))
+		assert.Equal(t, "2023-06-01T23:30:00.123456789+02:00", e.At.String())
+		assert.True(t, e.RemovedAt.IsDefined())
+		assert.True(t, e.RemovedAt.IsNil())
+		assert.False(t, e.Reviewed.IsDefined())
+	})
+
+	t.Run("Scan and Value", func(t *testing.T) {
+		var dt DateTime
+		require.NoError(t, dt.Scan(instant))
+		assert.Equal(t, NewDateTime(instant), dt)
+
+		value, err := dt.Value()
+		require.NoError(t, err)
+		assert.Equal(t, instant, value)
+
+		require.NoError(t, dt.Scan([]byte("2023-06-01 23:30:00.123456789+02:00")))
+		assert.True(t, instant.Equal(dt.DateTime()))
+
+		require.NoError(t, dt.Scan(nil))
+		assert.True(t, dt.IsDefined())
+		assert.True(t, dt.IsNil())
+	})
+
+	t.Run("ParseFromString", func(t *testing.T) {
+		value, err := ParseFromString("types.DateTime", "2023-06-01T23:30:00.123456789+02:00")
+		require.NoError(t, err)
+		assert.Equal(t, "2023-06-01T23:30:00.123456789+02:00", value.(DateTime).String())
+		assert.True(t, IsEmptyArray([]DateTime{}))
+	})
+}
+
+func TestDecodeQuery(t *testing.T) {
+	type filter struct {
+		From     Date `query:"from"`
+		Limit    Int  `json:"limit"`
+		Archived Bool
+		Owner    UUID `query:"-"`
+		Page     int
+		internal String
+	}
+
+	t.Run("decodes present keys", func(t *testing.T) {
+		values, err := url.ParseQuery("from=2024-01-31&limit=25&Archived=true&Page=2")
+		require.NoError(t, err)
+
+		var f filter
+		assert.Empty(t, DecodeQuery(values, &f))
+
+		assert.Equal(t, "2024-01-31", f.From.String())
+		assert.Equal(t, NewInt(25), f.Limit)
+		assert.Equal(t, NewBool(true), f.Archived)
+		assert.False(t, f.Owner.IsDefined())
+		assert.Zero(t, f.Page)
+	})
+
+	t.Run("missing keys are undefined and empty values nil", func(t *testing.T) {
+		f := filter{
+			From:     NewDate(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+			Archived: NewBool(false),
+		}
+
+		assert.Empty(t, DecodeQuery(url.Values{"limit": {""}}, &f))
+
+		assert.False(t, f.From.IsDefined())
+		assert.False(t, f.Archived.IsDefined())
+		assert.True(t, f.Limit.IsDefined())
+		assert.True(t, f.Limit.IsNil())
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		values := url.Values{"from": {"yesterday"}, "limit": {"ten"}, "Archived": {"false"}}
+
+		var f filter
+		errs := DecodeQuery(values, &f)
+		require.Len(t, errs, 2)
+		assert.ErrorContains(t, errs[0], "cannot decode query parameter from")
+		assert.ErrorContains(t, errs[1], "cannot decode query parameter limit")
+		assert.Equal(t, NewBool(false), f.Archived)
+	})
+
+	t.Run("not a pointer to a struct", func(t *testing.T) {
+		assert.Len(t, DecodeQuery(url.Values{}, filter{}), 1)
+	})
+
+	t.Run("wrapped types", func(t *testing.T) {
+		type wrappedFilter struct {
+			Active    BoolText        `query:"active"`
+			Since     UnixTimestamp   `query:"since"`
+			Until     TimestampMinute `query:"until"`
+			CreatedAt TimestampLocal  `query:"created_at"`
+		}
+
+		values := url.Values{
+			"active":     {"true"},
+			"since":      {"1700000000"},
+			"until":      {"2024-01-31T10:15:30Z"},
+			"created_at": {""},
+		}
+
+		var f wrappedFilter
+		assert.Empty(t, DecodeQuery(values, &f))
+
+		assert.Equal(t, NewBoolTextMode(NewBool(true)), f.Active)
+		assert.Equal(t, NewUnixTimestamp(NewTimestampFromUnix(1700000000)), f.Since)
+		assert.Equal(t, NewTimestampMinuteMode(NewTimestamp(time.Date(2024, 1, 31, 10, 15, 0, 0, time.UTC))), f.Until)
+		assert.True(t, f.CreatedAt.IsDefined())
+		assert.True(t, f.CreatedAt.IsNil())
+
+		errs := DecodeQuery(url.Values{"since": {"yesterday"}}, &f)
+		require.Len(t, errs, 1)
+		assert.ErrorContains(t, errs[0], "invalid unix timestamp")
+	})
+
+	t.Run("pointer fields", func(t *testing.T) {
+		type pointerFilter struct {
+			From  *Date `query:"from"`
+			Until *Date `query:"until"`
+			Limit *Int  `query:"limit"`
+		}
+
+		f := pointerFilter{Until: NewDate(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).Ptr()}
+		assert.Empty(t, DecodeQuery(url.Values{"from": {"2024-01-31"}, "limit": {""}}, &f))
+
+		require.NotNil(t, f.From)
+		assert.Equal(t, "2024-01-31", f.From.String())
+		assert.Nil(t, f.Until)
+		require.NotNil(t, f.Limit)
+		assert.True(t, f.Limit.IsDefined())
+		assert.True(t, f.Limit.IsNil())
+	})
+}
+
+func TestDecoder(t *testing.T) {
+	type person struct {
+		Name     String `json:"name"`
+		Nickname String `json:"nickname,omitempty"`
+		Age      Int
+		Ignored  String `json:"-"`
+		Count    int
+	}
+
+	t.Run("absent, null and present fields", func(t *testing.T) {
+		var p person
+		require.NoError(t, NewDecoder(strings.NewReader(`{"name":"Alice","nickname":null,"count":2}`)).Decode(&p))
+
+		assert.Equal(t, NewString("Alice"), p.Name)
+		assert.True(t, p.Nickname.IsDefined())
+		assert.True(t, p.Nickname.IsNil())
+		assert.False(t, p.Age.IsDefined())
+		assert.Equal(t, 2, p.Count)
+	})
+
+	t.Run("reused struct", func(t *testing.T) {
+		p := person{
+			Name:     NewString("Alice"),
+			Nickname: NewString("Ali"),
+			Age:      NewInt(30),
+			Ignored:  NewString("kept"),
+		}
+
+		require.NoError(t, NewDecoder(strings.NewReader(`{"AGE":31}`)).Decode(&p))
+
+		assert.False(t, p.Name.IsDefined())
+		assert.False(t, p.Nickname.IsDefined())
+		assert.Equal(t, NewInt(31), p.Age)
+		assert.Equal(t, NewString("kept"), p.Ignored)
+	})
+
+	t.Run("stream of values", func(t *testing.T) {
+		dec := NewDecoder(strings.NewReader(`{"name":"A"} {"Age":1}`))
+
+		var p person
+		require.NoError(t, dec.Decode(&p))
+		assert.Equal(t, NewString("A"), p.Name)
+
+		require.NoError(t, dec.Decode(&p))
+		assert.False(t, p.Name.IsDefined())
+		assert.Equal(t, NewInt(1), p.Age)
+
+		assert.ErrorIs(t, dec.Decode(&p), io.EOF)
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		var p person
+		assert.Error(t, NewDecoder(strings.NewReader(`{"name":1}`)).Decode(&p))
+	})
+}
+
+func TestFirstDefined(t *testing.T) {
+	t.Run("Int", func(t *testing.T) {
+		value, ok := FirstDefined(NewIntUndefined(), NewIntFromPtr(nil), NewInt(0), NewInt(2))
+		assert.True(t, ok)
+		assert.Equal(t, NewInt(0), value)
+
+		value, ok = FirstDefined(NewIntUndefined(), NewIntFromPtr(nil))
+		assert.False(t, ok)
+		assert.False(t, value.IsDefined())
+	})
+
+	t.Run("String", func(t *testing.T) {
+		value, ok := FirstDefined(NewStringFromPtr(nil), NewString("override"), NewString("stored"))
+		assert.True(t, ok)
+		assert.Equal(t, "override", value.String())
+
+		value, ok = FirstDefined[String]()
+		assert.False(t, ok)
+		assert.False(t, value.IsDefined())
+	})
+}
+
+func TestFloat64(t *testing.T) {
+	t.Run("Round", func(t *testing.T) {
+		tt := []struct {
+			name     string
+			input    float64
+			decimals int
+			mode     RoundingMode
+			expected float64
+		}{
+			{name: "half up 2.5", input: 2.5, decimals: 0, mode: RoundHalfUp, expected: 3},
+			{name: "half up 3.5", input: 3.5, decimals: 0, mode: RoundHalfUp, expected: 4},
+			{name: "half up -2.5", input: -2.5, decimals: 0, mode: RoundHalfUp, expected: -3},
+			{name: "half even 2.5", input: 2.5, decimals: 0, mode: RoundHalfEven, expected: 2},
+			{name: "half even 3.5", input: 3.5, decimals: 0, mode: RoundHalfEven, expected: 4},
+			{name: "half even 0.125", input: 0.125, decimals: 2, mode: RoundHalfEven, expected: 0.12},
+			{name: "truncate 2.9", input: 2.9, decimals: 0, mode: RoundTruncate, expected: 2},
+			{name: "truncate -2.9", input: -2.9, decimals: 0, mode: RoundTruncate, expected: -2},
+			{name: "truncate 1.239", input: 1.239, decimals: 2, mode: RoundTruncate, expected: 1.23},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				rounded := NewFloat64(tc.input).Round(tc.decimals, tc.mode)
+
+				assert.Equal(t, tc.expected, rounded.Float64())
+			})
+		}
+
+		t.Run("nil", func(t *testing.T) {
+			assert.True(t, NewFloat64FromPtr(nil).Round(2, RoundHalfEven).IsNil())
+			assert.False(t, NewFloat64Undefined().Round(2, RoundHalfEven).IsDefined())
+		})
+	})
+
+	t.Run("Format", func(t *testing.T) {
+		tt := []struct {
+			name     string
+			input    float64
+			opts     Float64FormatOptions
+			expected string
+		}{
+			{name: "dot", input: 1234.5, opts: Float64FormatOptions{DecimalSeparator: ".", Precision: 2}, expected: "1234.5"},
+			{name: "comma", input: 1234.5, opts: Float64FormatOptions{DecimalSeparator: ",", Precision: 2}, expected: "1234,5"},
+			{name: "rounded", input: 3.14159, opts: Float64FormatOptions{DecimalSeparator: ".", Precision: 3}, expected: "3.142"},
+			{name: "trailing zeros", input: 2.10, opts: Float64FormatOptions{DecimalSeparator: ".", Precision: 4}, expected: "2.1"},
+			{name: "integer", input: 1234567, opts: Float64FormatOptions{DecimalSeparator: ".", Precision: 2}, expected: "1234567"},
+			{name: "zero precision", input: 2.6, opts: Float64FormatOptions{DecimalSeparator: ".", Precision: 0}, expected: "3"},
+			{name: "negative precision", input: 0.1234567, opts: Float64FormatOptions{DecimalSeparator: ".", Precision: -1}, expected: "0.1234567"},
+			{name: "negative zero", input: -0.001, opts: Float64FormatOptions{DecimalSeparator: ".", Precision: 2}, expected: "0"},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				assert.Equal(t, tc.expected, NewFloat64(tc.input).Format(tc.opts))
+			})
+		}
+
+		assert.Equal(t, "", NewFloat64FromPtr(nil).Format(Float64FormatOptions{DecimalSeparator: ".", Precision: 2}))
+	})
+
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, "12.35", NewFloat64(12.345678).String())
+		assert.Equal(t, "12,35", NewFloat64(12.345678).StringLocalized())
+		assert.Equal(t, "", NewFloat64Undefined().String())
+		assert.Equal(t, "", NewFloat64Undefined().StringLocalized())
+
+		SetFloat64DecimalSeparator(",")
+		defer func() { SetFloat64DecimalSeparator(".") }()
+
+		assert.Equal(t, "12,35", NewFloat64(12.345678).String())
+	})
+
+	t.Run("SetFloat64DecimalSeparator is safe for concurrent use", func(t *testing.T) {
+		t.Cleanup(func() { SetFloat64DecimalSeparator(".") })
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+
+			for i := 0; i < 100; i++ {
+				_ = NewFloat64(1.5).String()
+			}
+		}()
+
+		for i := 0; i < 100; i++ {
+			SetFloat64DecimalSeparator([]string{".", ","}[i%2])
+		}
+
+		<-done
+	})
+
+	t.Run("Arithmetic", func(t *testing.T) {
+		a, b, null := NewFloat64(7.5), NewFloat64(2.5), NewFloat64FromPtr(nil)
+
+		assert.Equal(t, NewFloat64(10), a.Add(b))
+		assert.Equal(t, NewFloat64(5), a.Sub(b))
+		assert.Equal(t, NewFloat64(18.75), a.Mul(b))
+		assert.Equal(t, NewFloat64(3), a.Div(b))
+
+		assert.True(t, a.Add(null).IsNil())
+		assert.True(t, null.Sub(b).IsNil())
+		assert.True(t, a.Mul(NewFloat64Undefined()).IsNil())
+		assert.True(t, a.Div(null).IsNil())
+		assert.True(t, a.Div(NewFloat64(0)).IsNil())
+	})
+
+	t.Run("SumFloat64", func(t *testing.T) {
+		assert.Equal(t, NewFloat64(4), SumFloat64([]Float64{NewFloat64(1.5), NewFloat64FromPtr(nil), NewFloat64(2.5), NewFloat64Undefined()}))
+		assert.Equal(t, NewFloat64(0), SumFloat64([]Float64{NewFloat64(0)}))
+		assert.True(t, SumFloat64([]Float64{NewFloat64FromPtr(nil), NewFloat64Undefined()}).IsNil())
+		assert.True(t, SumFloat64(nil).IsNil())
+	})
+
+	t.Run("Float64OrDefault", func(t *testing.T) {
+		assert.Equal(t, 2.5, Float64OrDefault(NewFloat64(2.5), 1.5))
+		assert.Equal(t, 1.5, Float64OrDefault(NewFloat64FromPtr(nil), 1.5))
+		assert.Equal(t, 1.5, Float64OrDefault(NewFloat64Undefined(), 1.5))
+	})
+
+	t.Run("EqualRelative", func(t *testing.T) {
+		tt := []struct {
+			name     string
+			a, b     Float64
+			relTol   float64
+			expected bool
+		}{
+			{name: "large magnitude within", a: NewFloat64(1e12), b: NewFloat64(1e12 + 500), relTol: 1e-9, expected: true},
+			{name: "large magnitude outside", a: NewFloat64(1e12), b: NewFloat64(1e12 + 5000), relTol: 1e-9, expected: false},
+			{name: "small magnitude within", a: NewFloat64(1e-12), b: NewFloat64(1.0000000001e-12), relTol: 1e-9, expected: true},
+			{name: "small magnitude outside", a: NewFloat64(1e-12), b: NewFloat64(2e-12), relTol: 1e-9, expected: false},
+			{name: "rounding error", a: NewFloat64(0.1 + 0.2), b: NewFloat64(0.3), relTol: 1e-9, expected: true},
+			{name: "zero", a: NewFloat64(0), b: NewFloat64(0), relTol: 0, expected: true},
+			{name: "zero and small", a: NewFloat64(0), b: NewFloat64(1e-300), relTol: 1e-9, expected: false},
+			{name: "opposite signs", a: NewFloat64(1), b: NewFloat64(-1), relTol: 1, expected: false},
+			{name: "both nil", a: NewFloat64FromPtr(nil), b: NewFloat64Undefined(), relTol: 1e-9, expected: true},
+			{name: "one nil", a: NewFloat64(0), b: NewFloat64FromPtr(nil), relTol: 1e-9, expected: false},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				assert.Equal(t, tc.expected, tc.a.EqualRelative(tc.b, tc.relTol))
+				assert.Equal(t, tc.expected, tc.b.EqualRelative(tc.a, tc.relTol))
+			})
+		}
+	})
+
+	t.Run("MinFloat64 and MaxFloat64", func(t *testing.T) {
+		values := []Float64{NewFloat64(1.5), NewFloat64FromPtr(nil), NewFloat64(-0.5)}
+		assert.Equal(t, NewFloat64(-0.5), MinFloat64(values))
+		assert.Equal(t, NewFloat64(1.5), MaxFloat64(values))
+		assert.Equal(t, NewFloat64(2), MaxFloat64([]Float64{NewFloat64(2)}))
+		assert.True(t, MinFloat64([]Float64{NewFloat64FromPtr(nil)}).IsNil())
+		assert.True(t, MaxFloat64([]Float64{}).IsNil())
+
+		assert.Equal(t, NewFloat64(1), NewFloat64(1.5).Clamp(NewFloat64(0), NewFloat64(1)))
+		assert.Equal(t, NewFloat64(0), NewFloat64(-0.1).Clamp(NewFloat64(0), NewFloat64(1)))
+	})
+
+	t.Run("Scan from text", func(t *testing.T) {
+		SetFloat64DecimalSeparator(",")
+		t.Cleanup(func() { SetFloat64DecimalSeparator(".") })
+
+		var f Float64
+		require.NoError(t, f.Scan([]byte("3.14")))
+		assert.Equal(t, NewFloat64(3.14), f)
+
+		require.NoError(t, f.Scan("-1234.5678901234"))
+		assert.Equal(t, NewFloat64(-1234.5678901234), f)
+
+		require.NoError(t, f.Scan(" 42 "))
+		assert.Equal(t, NewFloat64(42), f)
+
+		assert.Error(t, f.Scan("3,14"))
+		assert.Error(t, f.Scan([]byte("abc")))
+	})
+}
+
+func TestFromStrings(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		ints, err := IntsFromStrings([]string{"1", " 2", ""})
+		require.NoError(t, err)
+		assert.Equal(t, []Int{NewInt(1), NewInt(2), NewIntFromPtr(nil)}, ints)
+		assert.Equal(t, []string{"1", "2", ""}, IntsToStrings(ints))
+
+		floats, err := Float64sFromStrings([]string{"1.5", "-2"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1.5", "-2"}, Float64sToStrings(floats))
+
+		dates, err := DatesFromStrings([]string{"2024-01-02"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"2024-01-02"}, DatesToStrings(dates))
+
+		strs, err := StringsFromStrings([]string{"a", "b"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, StringsToStrings(strs))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		ints, err := IntsFromStrings([]string{"1", "two"})
+		assert.ErrorContains(t, err, "cannot parse element 1")
+		assert.Nil(t, ints)
+
+		_, err = BoolsFromStrings([]string{"maybe"})
+		assert.Error(t, err)
+
+		_, err = Int16sFromStrings([]string{"abc"})
+		assert.Error(t, err)
+
+		_, err = TimestampsFromStrings([]string{"yesterday"})
+		assert.Error(t, err)
+	})
+
+	t.Run("UUIDsFromStringsE", func(t *testing.T) {
+		id := NewRandomUUID()
+
+		uuids, err := UUIDsFromStringsE([]string{id.String()})
+		require.NoError(t, err)
+		assert.Equal(t, []UUID{id}, uuids)
+
+		_, err = UUIDsFromStringsE([]string{id.String(), "not-a-uuid"})
+		assert.ErrorContains(t, err, "cannot parse element 1")
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		ints, err := IntsFromStrings(nil)
+		require.NoError(t, err)
+		assert.Empty(t, ints)
+		assert.Empty(t, Int64sToStrings(nil))
+	})
+}
+
+func TestHashStruct(t *testing.T) {
+	type record struct {
+		ID       UUID
+		Name     String
+		Age      Int
+		Score    Nullable[uint8]
+		Internal string
+	}
+
+	id := NewRandomUUID()
+
+	hash := func(r record) uint64 {
+		h, err := HashStruct(r)
+		require.NoError(t, err)
+		return h
+	}
+
+	base := record{ID: id, Name: NewString("Alice"), Age: NewInt(30), Score: NewNullable(uint8(5))}
+
+	t.Run("stable", func(t *testing.T) {
+		assert.Equal(t, hash(base), hash(base))
+		assert.Equal(t, hash(base), hash(record{ID: id, Name: NewString("Alice"), Age: NewInt(30), Score: NewNullable(uint8(5)), Internal: "ignored"}))
+
+		h, err := HashStruct(&base)
+		require.NoError(t, err)
+		assert.Equal(t, hash(base), h)
+	})
+
+	t.Run("changes", func(t *testing.T) {
+		changed := base
+		changed.Name = NewString("Bob")
+		assert.NotEqual(t, hash(base), hash(changed))
+
+		changed = base
+		changed.Score = NewNullable(uint8(6))
+		assert.NotEqual(t, hash(base), hash(changed))
+	})
+
+	t.Run("nil and undefined", func(t *testing.T) {
+		assert.Equal(t, NewIntUndefined().Hash(), Int{}.Hash())
+		assert.NotEqual(t, NewIntUndefined().Hash(), NewIntFromPtr(nil).Hash())
+		assert.NotEqual(t, NewIntFromPtr(nil).Hash(), NewInt(0).Hash())
+		assert.Equal(t, hash(record{}), hash(record{}))
+
+		nilName := base
+		nilName.Name = NewStringFromPtr(nil)
+		undefinedName := base
+		undefinedName.Name = NewStringUndefined()
+		assert.NotEqual(t, hash(nilName), hash(undefinedName))
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		_, err := HashStruct("record")
+		assert.Error(t, err)
+	})
+
+	t.Run("pointer fields", func(t *testing.T) {
+		type withPointer struct {
+			Name *String
+		}
+
+		h, err := HashStruct(withPointer{})
+		require.NoError(t, err)
+
+		undefined, err := HashStruct(withPointer{Name: NewStringUndefined().Ptr()})
+		require.NoError(t, err)
+		assert.Equal(t, undefined, h)
+
+		alice, err := HashStruct(withPointer{Name: NewString("Alice").Ptr()})
+		require.NoError(t, err)
+		assert.NotEqual(t, h, alice)
+	})
+
+	t.Run("equal values hash equal", func(t *testing.T) {
+		instant := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		stockholm := instant.In(time.FixedZone("CEST", 2*60*60))
+
+		assert.Equal(t, NewTimestamp(instant).Hash(), NewTimestamp(stockholm).Hash())
+		assert.Equal(t, NewDateTime(instant).Hash(), NewDateTime(stockholm).Hash())
+		assert.NotEqual(t, NewTimestamp(instant).Hash(), NewTimestamp(instant.Add(time.Second)).Hash())
+		assert.Equal(t, NewFloat64(0).Hash(), NewFloat64(math.Copysign(0, -1)).Hash())
+	})
+}
+
+func TestHumanDiff(t *testing.T) {
+	type student struct {
+		Name      String
+		Email     String
+		Phone     String
+		BirthDate Date
+		Grade     Int
+		Year      int
+		note      string
+	}
+
+	old := student{
+		Name:      NewString("Alice"),
+		Email:     NewString("alice@example.com"),
+		Phone:     NewStringFromPtr(nil),
+		BirthDate: NewDate(time.Date(2010, 4, 1, 0, 0, 0, 0, time.UTC)),
+		Grade:     NewInt(4),
+		Year:      2024,
+		note:      "old",
+	}
+
+	t.Run("changed fields", func(t *testing.T) {
+		changed := student{
+			Name:      NewString("Alicia"),
+			Email:     NewStringFromPtr(nil),
+			Phone:     NewString("070-123 45 67"),
+			BirthDate: NewDate(time.Date(2010, 4, 1, 0, 0, 0, 0, time.UTC)),
+			Grade:     NewIntUndefined(),
+			Year:      2025,
+			note:      "new",
+		}
+
+		labels := map[string]string{
+			"Name":  "First name",
+			"Phone": "Phone number",
+		}
+
+		assert.Equal(t, []string{
+			`First name changed from "Alice" to "Alicia"`,
+			`Email cleared, was "alice@example.com"`,
+			`Phone number set to "070-123 45 67"`,
+			`Year changed from "2024" to "2025"`,
+		}, HumanDiff(old, &changed, labels))
+	})
+
+	t.Run("undefined fields are skipped", func(t *testing.T) {
+		assert.Empty(t, HumanDiff(old, student{Year: 2024}, nil))
+	})
+
+	t.Run("different types", func(t *testing.T) {
+		assert.Nil(t, HumanDiff(old, struct{ Name String }{}, nil))
+		assert.Nil(t, HumanDiff("a", "b", nil))
+	})
+
+	t.Run("pointer fields", func(t *testing.T) {
+		type guardian struct {
+			Name  *String
+			Email *String
+			Phone *String
+		}
+
+		oldGuardian := guardian{
+			Name:  NewString("Bob").Ptr(),
+			Email: nil,
+			Phone: nil,
+		}
+
+		newGuardian := guardian{
+			Name:  NewString("Robert").Ptr(),
+			Email: NewString("bob@example.com").Ptr(),
+			Phone: nil,
+		}
+
+		assert.Equal(t, []string{
+			`Name changed from "Bob" to "Robert"`,
+			`Email set to "bob@example.com"`,
+		}, HumanDiff(oldGuardian, newGuardian, nil))
+		assert.Equal(t, []string{
+			`Name changed from "Robert" to "Bob"`,
+		}, HumanDiff(newGuardian, oldGuardian, nil))
+	})
+
+	t.Run("values are compared rather than their output", func(t *testing.T) {
+		type address struct {
+			Street String
+			Zip    Int
+		}
+
+		type invoice struct {
+			Amount    Float64
+			Count     Nullable[int]
+			CreatedAt Timestamp
+			Address   address
+			Billing   *address
+		}
+
+		createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		oldInvoice := invoice{
+			Amount:    NewFloat64(1.001),
+			Count:     NewNullable(1),
+			CreatedAt: NewTimestamp(createdAt),
+			Address:   address{Street: NewString("Storgatan 1"), Zip: NewInt(11122)},
+			Billing:   &address{Street: NewString("Box 1")},
+		}
+
+		newInvoice := invoice{
+			Amount:    NewFloat64(1.004),
+			Count:     NewNullable(2),
+			CreatedAt: NewTimestamp(createdAt.In(time.FixedZone("", 2*60*60))),
+			Address:   address{Street: NewString("Storgatan 2"), Zip: NewInt(11122)},
+			Billing:   &address{Street: NewString("Box 2")},
+		}
+
+		assert.Equal(t, []string{
+			`Amount changed from "1" to "1"`,
+			`Count changed from "1" to "2"`,
+			`Street address changed from "Storgatan 1" to "Storgatan 2"`,
+			`Billing.Street changed from "Box 1" to "Box 2"`,
+		}, HumanDiff(oldInvoice, newInvoice, map[string]string{"Address.Street": "Street address"}))
+	})
+}
+
+func TestInitUndefined(t *testing.T) {
+	type address struct {
+		Street String
+		Zip    Int
+	}
+
+	type audit struct {
+		CreatedAt Timestamp
+	}
+
+	type person struct {
+		audit
+		Name     String
+		Active   BoolText
+		Address  address
+		Previous *address
+		Missing  *address
+		Count    int
+	}
+
+	t.Run("InitUndefined", func(t *testing.T) {
+		p := person{
+			audit:    audit{CreatedAt: NewTimestampFromPtr(nil)},
+			Name:     NewString("Alice"),
+			Active:   NewBoolTextMode(NewBool(true)),
+			Address:  address{Street: NewString("Storgatan 1"), Zip: NewInt(11122)},
+			Previous: &address{Street: NewStringFromPtr(nil), Zip: NewInt(22233)},
+			Count:    3,
+		}
+
+		require.NoError(t, InitUndefined(&p))
+
+		for _, v := range []NullableValue{p.CreatedAt, p.Name, p.Active, p.Address.Street, p.Address.Zip, p.Previous.Street, p.Previous.Zip} {
+			assert.False(t, v.IsDefined())
+		}
+
+		assert.Nil(t, p.Missing)
+		assert.Equal(t, 3, p.Count)
+	})
+
+	t.Run("ZeroStruct", func(t *testing.T) {
+		p := ZeroStruct[person]()
+		assert.False(t, p.Name.IsDefined())
+		assert.False(t, p.Address.Zip.IsDefined())
+	})
+
+	t.Run("invalid argument", func(t *testing.T) {
+		assert.Error(t, InitUndefined(person{}))
+		assert.Error(t, InitUndefined((*person)(nil)))
+	})
+}
+
+func TestInt(t *testing.T) {
+	t.Run("Arithmetic", func(t *testing.T) {
+		a, b, null := NewInt(7), NewInt(2), NewIntFromPtr(nil)
+
+		assert.Equal(t, NewInt(9), a.Add(b))
+		assert.Equal(t, NewInt(5), a.Sub(b))
+		assert.Equal(t, NewInt(14), a.Mul(b))
+		assert.Equal(t, NewInt(3), a.Div(b))
+		assert.Equal(t, NewInt(-3), NewInt(-7).Div(b))
+
+		assert.True(t, a.Add(null).IsNil())
+		assert.True(t, null.Sub(b).IsNil())
+		assert.True(t, a.Mul(NewIntUndefined()).IsNil())
+		assert.True(t, a.Div(null).IsNil())
+		assert.True(t, a.Div(NewInt(0)).IsNil())
+	})
+
+	t.Run("SumInt", func(t *testing.T) {
+		assert.Equal(t, NewInt(6), SumInt([]Int{NewInt(1), NewIntFromPtr(nil), NewInt(5), NewIntUndefined()}))
+		assert.True(t, SumInt([]Int{NewIntFromPtr(nil), NewIntUndefined()}).IsNil())
+		assert.True(t, SumInt(nil).IsNil())
+	})
+
+	t.Run("IntOrDefault", func(t *testing.T) {
+		assert.Equal(t, 3, IntOrDefault(NewInt(3), 7))
+		assert.Equal(t, 7, IntOrDefault(NewIntFromPtr(nil), 7))
+		assert.Equal(t, 7, IntOrDefault(NewIntUndefined(), 7))
+	})
+
+	t.Run("IntFromStringClamped", func(t *testing.T) {
+		value, err := IntFromStringClamped("2147483647", false)
+		require.NoError(t, err)
+		assert.Equal(t, 2147483647, value.Int())
+
+		value, err = IntFromStringClamped("2147483648", true)
+		require.NoError(t, err)
+		assert.Equal(t, 2147483647, value.Int())
+
+		value, err = IntFromStringClamped("-2147483649", true)
+		require.NoError(t, err)
+		assert.Equal(t, -2147483648, value.Int())
+
+		_, err = IntFromStringClamped("2147483648", false)
+		assert.EqualError(t, err, "value 2147483648 is out of range [-2147483648, 2147483647]")
+
+		value, err = IntFromStringClamped("", true)
+		require.NoError(t, err)
+		assert.True(t, value.IsNil())
+	})
+
+	t.Run("MinInt and MaxInt", func(t *testing.T) {
+		values := []Int{NewIntFromPtr(nil), NewInt(3), NewIntUndefined(), NewInt(-2), NewInt(7)}
+		assert.Equal(t, NewInt(-2), MinInt(values))
+		assert.Equal(t, NewInt(7), MaxInt(values))
+
+		assert.Equal(t, NewInt(5), MinInt([]Int{NewInt(5)}))
+		assert.Equal(t, NewInt(5), MaxInt([]Int{NewInt(5)}))
+
+		assert.True(t, MinInt([]Int{NewIntFromPtr(nil), NewIntUndefined()}).IsNil())
+		assert.True(t, MaxInt(nil).IsNil())
+		assert.True(t, MaxInt(nil).IsDefined())
+	})
+
+	t.Run("Clamp", func(t *testing.T) {
+		low, high := NewInt(0), NewInt(10)
+
+		assert.Equal(t, NewInt(5), NewInt(5).Clamp(low, high))
+		assert.Equal(t, NewInt(0), NewInt(-5).Clamp(low, high))
+		assert.Equal(t, NewInt(10), NewInt(50).Clamp(low, high))
+		assert.Equal(t, NewInt(50), NewInt(50).Clamp(low, NewIntFromPtr(nil)))
+		assert.Equal(t, NewInt(-5), NewInt(-5).Clamp(NewIntUndefined(), high))
+		assert.True(t, NewIntFromPtr(nil).Clamp(low, high).IsNil())
+	})
+
+	t.Run("Scan from text", func(t *testing.T) {
+		var i Int
+		require.NoError(t, i.Scan([]byte("123")))
+		assert.Equal(t, NewInt(123), i)
+
+		require.NoError(t, i.Scan(" 42 "))
+		assert.Equal(t, NewInt(42), i)
+
+		assert.Error(t, i.Scan("abc"))
+	})
+}
+
+func TestInt16(t *testing.T) {
+	t.Run("Int16FromStringClamped", func(t *testing.T) {
+		tt := []struct {
+			input    string
+			clamp    bool
+			expected int16
+			wantErr  bool
+		}{
+			{input: "32767", clamp: false, expected: 32767},
+			{input: "-32768", clamp: false, expected: -32768},
+			{input: "32768", clamp: true, expected: 32767},
+			{input: "-32769", clamp: true, expected: -32768},
+			{input: "99999999999999999999", clamp: true, expected: 32767},
+			{input: "32768", clamp: false, wantErr: true},
+			{input: "-99999999999999999999", clamp: false, wantErr: true},
+			{input: "abc", clamp: true, wantErr: true},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.input, func(t *testing.T) {
+				value, err := Int16FromStringClamped(tc.input, tc.clamp)
+				if tc.wantErr {
+					assert.Error(t, err)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, value.Int16())
+			})
+		}
+	})
+
+	t.Run("Int16FromString out of range", func(t *testing.T) {
+		_, err := Int16FromString("70000")
+		assert.Error(t, err)
+
+		_, err = Int16FromString("-32769")
+		assert.Error(t, err)
+
+		assert.Panics(t, func() { MustInt16FromString("70000") })
+		assert.Equal(t, NewInt16(-32768), MustInt16FromString("-32768"))
+	})
+
+	t.Run("Min, Max and Clamp", func(t *testing.T) {
+		values := []Int16{NewInt16(4), NewInt16FromPtr(nil), NewInt16(-4)}
+		assert.Equal(t, NewInt16(-4), MinInt16(values))
+		assert.Equal(t, NewInt16(4), MaxInt16(values))
+		assert.True(t, MinInt16(nil).IsNil())
+		assert.Equal(t, NewInt16(0), NewInt16(-4).Clamp(NewInt16(0), NewInt16(3)))
+	})
+
+	t.Run("Scan from text", func(t *testing.T) {
+		var i Int16
+		require.NoError(t, i.Scan([]byte("123")))
+		assert.Equal(t, NewInt16(123), i)
+
+		require.NoError(t, i.Scan(" 42 "))
+		assert.Equal(t, NewInt16(42), i)
+
+		assert.Error(t, i.Scan("abc"))
+		assert.Error(t, i.Scan("70000"))
+	})
+}
+
+func TestInt64(t *testing.T) {
+	t.Run("Arithmetic", func(t *testing.T) {
+		a, b, null := NewInt64(1<<40), NewInt64(2), NewInt64FromPtr(nil)
+
+		assert.Equal(t, NewInt64(1<<40+2), a.Add(b))
+		assert.Equal(t, NewInt64(1<<40-2), a.Sub(b))
+		assert.Equal(t, NewInt64(1<<41), a.Mul(b))
+		assert.Equal(t, NewInt64(1<<39), a.Div(b))
+
+		assert.True(t, a.Add(null).IsNil())
+		assert.True(t, a.Div(NewInt64(0)).IsNil())
+	})
+
+	t.Run("SumInt64", func(t *testing.T) {
+		assert.Equal(t, NewInt64(1<<40+1), SumInt64([]Int64{NewInt64(1 << 40), NewInt64FromPtr(nil), NewInt64(1)}))
+		assert.True(t, SumInt64([]Int64{NewInt64Undefined()}).IsNil())
+	})
+
+	t.Run("Int64OrDefault", func(t *testing.T) {
+		assert.Equal(t, int64(3), Int64OrDefault(NewInt64(3), 7))
+		assert.Equal(t, int64(7), Int64OrDefault(NewInt64FromPtr(nil), 7))
+		assert.Equal(t, int64(7), Int64OrDefault(NewInt64Undefined(), 7))
+	})
+
+	t.Run("Min, Max and Clamp", func(t *testing.T) {
+		values := []Int64{NewInt64(4), NewInt64FromPtr(nil), NewInt64(-4)}
+		assert.Equal(t, NewInt64(-4), MinInt64(values))
+		assert.Equal(t, NewInt64(4), MaxInt64(values))
+		assert.True(t, MinInt64(nil).IsNil())
+		assert.Equal(t, NewInt64(0), NewInt64(-4).Clamp(NewInt64(0), NewInt64(3)))
+	})
+
+	t.Run("Scan from text", func(t *testing.T) {
+		var i Int64
+		require.NoError(t, i.Scan([]byte("123")))
+		assert.Equal(t, NewInt64(123), i)
+
+		require.NoError(t, i.Scan(" 42 "))
+		assert.Equal(t, NewInt64(42), i)
+
+		assert.Error(t, i.Scan("abc"))
+	})
+}
+
+func TestJSON(t *testing.T) {
+	t.Run("Scan", func(t *testing.T) {
+		for _, value := range []interface{}{[]byte(`{"a":1}`), `{"a":1}`, json.RawMessage(`{"a":1}`)} {
+			t.Run(fmt.Sprintf("%T", value), func(t *testing.T) {
+				var j JSON
+				err := j.Scan(value)
+				require.NoError(t, err)
+
+				assert.True(t, j.IsDefined())
+				assert.False(t, j.IsNil())
+				assert.Equal(t, `{"a":1}`, j.String())
+
+				v, err := j.Value()
+				require.NoError(t, err)
+				assert.Equal(t, `{"a":1}`, v)
+			})
+		}
+
+		var j JSON
+		require.NoError(t, j.Scan(nil))
+		assert.True(t, j.IsDefined())
+		assert.True(t, j.IsNil())
+
+		err := j.Scan(42)
+		require.Error(t, err)
+		assert.Equal(t, "incompatible type for json: int", err.Error())
+	})
+
+	t.Run("Unmarshal", func(t *testing.T) {
+		var settings struct {
+			Enabled bool `json:"enabled"`
+		}
+
+		err := NewJSON(json.RawMessage(`{"enabled":true}`)).Unmarshal(&settings)
+		require.NoError(t, err)
+		assert.True(t, settings.Enabled)
+
+		err = NewJSONFromPtr(nil).Unmarshal(&settings)
+		require.Error(t, err)
+		assert.Equal(t, "cannot unmarshal nil JSON", err.Error())
+
+		err = NewJSONUndefined().Unmarshal(&settings)
+		require.Error(t, err)
+		assert.Equal(t, "cannot unmarshal undefined JSON", err.Error())
+	})
+
+	t.Run("JSONFromString", func(t *testing.T) {
+		tt := []struct {
+			input, expected string
+			err             error
+		}{
+			{input: `{"enabled":true}`, expected: `{"enabled":true}`},
+			{input: ` [1, {"a": "b"}] `, expected: `[1, {"a": "b"}]`},
+			{input: `"text"`, expected: `"text"`},
+			{input: `42`, expected: `42`},
+			{input: `{"enabled":`, err: errors.New(`invalid json: {"enabled":`)},
+			{input: `text`, err: errors.New(`invalid json: text`)},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.input, func(t *testing.T) {
+				j, err := JSONFromString(tc.input)
+
+				if tc.err != nil {
+					require.Error(t, err)
+					assert.Equal(t, tc.err.Error(), err.Error())
+					return
+				}
+
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expected, j.String())
+
+				jsonBytes, err := json.Marshal(j)
+				require.NoError(t, err)
+				assert.JSONEq(t, tc.expected, string(jsonBytes))
+			})
+		}
+
+		j, err := JSONFromString("")
+		require.NoError(t, err)
+		assert.True(t, j.IsNil())
+	})
+
+	t.Run("EqualJSON", func(t *testing.T) {
+		tt := []struct {
+			name     string
+			a, b     JSON
+			expected bool
+		}{
+			{"key order and whitespace", NewJSON(json.RawMessage(`{"a": 1, "b": [1, 2]}`)), NewJSON(json.RawMessage(`{"b":[1,2],"a":1}`)), true},
+			{"different values", NewJSON(json.RawMessage(`{"a":1}`)), NewJSON(json.RawMessage(`{"a":2}`)), false},
+			{"array order matters", NewJSON(json.RawMessage(`[1,2]`)), NewJSON(json.RawMessage(`[2,1]`)), false},
+			{"both nil", NewJSONFromPtr(nil), NewJSONUndefined(), true},
+			{"nil and empty object", NewJSONFromPtr(nil), NewJSON(json.RawMessage(`{}`)), false},
+			{"nil and null", NewJSON(json.RawMessage(`null`)), NewJSONFromPtr(nil), false},
+			{"large integers", NewJSON(json.RawMessage(`{"id":9007199254740993}`)), NewJSON(json.RawMessage(`{"id":9007199254740992}`)), false},
+			{"equal numbers", NewJSON(json.RawMessage(`[1, 1.50, -0, 1e400]`)), NewJSON(json.RawMessage(`[1.0, 15e-1, 0, 10e399]`)), true},
+			{"number and string", NewJSON(json.RawMessage(`[1]`)), NewJSON(json.RawMessage(`["1e0"]`)), false},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				equal, err := tc.a.EqualJSON(tc.b)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, equal)
+
+				equal, err = tc.b.EqualJSON(tc.a)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, equal)
+			})
+		}
+
+		_, err := NewJSON(json.RawMessage(`{"a":`)).EqualJSON(NewJSON(json.RawMessage(`{}`)))
+		assert.Error(t, err)
+
+		_, err = NewJSON(json.RawMessage(`{}`)).EqualJSON(NewJSON(json.RawMessage(`{"a":`)))
+		assert.Error(t, err)
+
+		_, err = NewJSON(json.RawMessage(`{} {}`)).EqualJSON(NewJSON(json.RawMessage(`{}`)))
+		assert.Error(t, err)
+	})
+
+	t.Run("Compact", func(t *testing.T) {
+		compacted, err := NewJSON(json.RawMessage("{\n  \"a\": [1, 2],\n  \"b\": \"x y\"\n}")).Compact()
+		require.NoError(t, err)
+		assert.Equal(t, `{"a":[1,2],"b":"x y"}`, compacted.String())
+
+		compacted, err = NewJSONFromPtr(nil).Compact()
+		require.NoError(t, err)
+		assert.True(t, compacted.IsNil())
+
+		_, err = NewJSON(json.RawMessage(`{"a":`)).Compact()
+		assert.Error(t, err)
+	})
+
+	t.Run("SemanticallyEqual", func(t *testing.T) {
+		equal, err := NewJSON(json.RawMessage(`{"a": 1, "b": [1, 2]}`)).SemanticallyEqual(NewJSON(json.RawMessage(`{"b":[1,2],"a":1}`)))
+		require.NoError(t, err)
+		assert.True(t, equal)
+
+		equal, err = NewJSON(json.RawMessage(`{"a": 1}`)).SemanticallyEqual(NewJSON(json.RawMessage(`{"a": 2}`)))
+		require.NoError(t, err)
+		assert.False(t, equal)
+	})
+
+	t.Run("ChangedKeys", func(t *testing.T) {
+		tt := []struct {
+			name     string
+			old, new JSON
+			expected []string
+		}{
+			{
+				name:     "reordered",
+				old:      NewJSON(json.RawMessage(`{"name": "Alice", "tags": {"x": 1, "y": 2}}`)),
+				new:      NewJSON(json.RawMessage(`{"tags":{"y":2,"x":1},"name":"Alice"}`)),
+				expected: []string{},
+			},
+			{
+				name:     "changed, added and removed",
+				old:      NewJSON(json.RawMessage(`{"name": "Alice", "age": 30, "city": "Lund"}`)),
+				new:      NewJSON(json.RawMessage(`{"name": "Alice", "age": 31, "zip": "22100"}`)),
+				expected: []string{"age", "city", "zip"},
+			},
+			{
+				name:     "nested change",
+				old:      NewJSON(json.RawMessage(`{"settings": {"theme": "dark"}}`)),
+				new:      NewJSON(json.RawMessage(`{"settings": {"theme": "light"}}`)),
+				expected: []string{"settings"},
+			},
+			{
+				name:     "from nil",
+				old:      NewJSONFromPtr(nil),
+				new:      NewJSON(json.RawMessage(`{"b": 1, "a": null}`)),
+				expected: []string{"a", "b"},
+			},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				keys, err := tc.old.ChangedKeys(tc.new)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, keys)
+			})
+		}
+
+		_, err := NewJSON(json.RawMessage(`[1, 2]`)).ChangedKeys(NewJSON(json.RawMessage(`{}`)))
+		assert.Error(t, err)
+
+		_, err = NewJSON(json.RawMessage(`{}`)).ChangedKeys(NewJSON(json.RawMessage(`null`)))
+		assert.Error(t, err)
+	})
+
+	t.Run("JSON Pointer", func(t *testing.T) {
+		doc := NewJSON(json.RawMessage(`{
+			"student": {"name": "Alice", "grades": [{"course": "MA1", "grade": "A"}, {"course": "SV1", "grade": null}]},
+			"a/b": 1,
+			"m~n": 2,
+			"": 3
+		}`))
+
+		tt := []struct {
+			ptr      string
+			expected string
+		}{
+			{"/student/name", `"Alice"`},
+			{"/student/grades/0/course", `"MA1"`},
+			{"/student/grades/1/grade", `null`},
+			{"/a~1b", `1`},
+			{"/m~0n", `2`},
+			{"/", `3`},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.ptr, func(t *testing.T) {
+				value, err := doc.GetPointer(tc.ptr)
+				require.NoError(t, err)
+				assert.JSONEq(t, tc.expected, string(value.JSON()))
+
+				found, err := doc.HasPointer(tc.ptr)
+				require.NoError(t, err)
+				assert.True(t, found)
+			})
+		}
+
+		whole, err := doc.GetPointer("")
+		require.NoError(t, err)
+		assert.Equal(t, doc, whole)
+
+		for _, ptr := range []string{"/missing", "/student/age", "/student/grades/2", "/student/grades/01", "/student/grades/-", "/student/name/first", "/a/b"} {
+			found, err := doc.HasPointer(ptr)
+			require.NoError(t, err, ptr)
+			assert.False(t, found, ptr)
+
+			_, err = doc.GetPointer(ptr)
+			assert.Error(t, err, ptr)
+		}
+
+		_, err = doc.HasPointer("student")
+		assert.Error(t, err)
+
+		_, err = doc.HasPointer("/m~2n")
+		assert.Error(t, err)
+
+		_, err = NewJSON(json.RawMessage(`{"a": `)).HasPointer("/a")
+		assert.Error(t, err)
+
+		found, err := NewJSONFromPtr(nil).HasPointer("/a")
+		require.NoError(t, err)
+		assert.False(t, found)
+
+		_, err = NewJSONUndefined().GetPointer("/a")
+		assert.Error(t, err)
+	})
+}
+
+func TestJSONArray(t *testing.T) {
+	t.Run("Scan", func(t *testing.T) {
+		var array JSONArray
+		err := array.Scan([]byte(`[{"a":1}, {"b":[1,2]}, null]`))
+		require.NoError(t, err)
+
+		require.Len(t, array, 3)
+		assert.Equal(t, `{"a":1}`, array[0].String())
+		assert.Equal(t, `{"b":[1,2]}`, array[1].String())
+		assert.True(t, array[2].IsDefined())
+		assert.True(t, array[2].IsNil())
+
+		value, err := array.Value()
+		require.NoError(t, err)
+		assert.Equal(t, `[{"a":1},{"b":[1,2]},null]`, value)
+	})
+
+	t.Run("Scan empty array", func(t *testing.T) {
+		var array JSONArray
+		err := array.Scan(`[]`)
+		require.NoError(t, err)
+
+		assert.NotNil(t, array)
+		assert.Len(t, array, 0)
+
+		value, err := array.Value()
+		require.NoError(t, err)
+		assert.Equal(t, `[]`, value)
+	})
+
+	t.Run("Scan null", func(t *testing.T) {
+		array := JSONArray{NewJSON(json.RawMessage(`1`))}
+		err := array.Scan(nil)
+		require.NoError(t, err)
+
+		assert.Nil(t, array)
+
+		value, err := array.Value()
+		require.NoError(t, err)
+		assert.Nil(t, value)
+	})
+
+	t.Run("Scan non-array", func(t *testing.T) {
+		var array JSONArray
+		err := array.Scan([]byte(`{"a":1}`))
+		require.Error(t, err)
+		assert.Equal(t, `cannot scan non-array JSON into JSONArray: {"a":1}`, err.Error())
+	})
+}
+
+func TestMoney(t *testing.T) {
+	t.Run("MoneyFromString", func(t *testing.T) {
+		tt := []struct {
+			input    string
+			amount   int64
+			currency string
+		}{
+			{"12.50 SEK", 1250, "SEK"},
+			{"12.5 sek", 1250, "SEK"},
+			{"12 EUR", 1200, "EUR"},
+			{"-0.05 USD", -5, "USD"},
+			{"1000 JPY", 1000, "JPY"},
+			{"1.234 KWD", 1234, "KWD"},
+			{"1500 XOF", 1500, "XOF"},
+			{"1.2345 CLF", 12345, "CLF"},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.input, func(t *testing.T) {
+				m, err := MoneyFromString(tc.input)
+				require.NoError(t, err)
+				assert.Equal(t, tc.amount, m.AmountMinor())
+				assert.Equal(t, tc.currency, m.Currency())
+			})
+		}
+
+		for _, input := range []string{"12.50", "12.505 SEK", "1.5 JPY", "abc SEK", "12.50 SEKK", "1.-5 SEK", "12.50 ABC", "1.5 UGX"} {
+			_, err := MoneyFromString(input)
+			assert.Error(t, err, input)
+		}
+
+		m, err := MoneyFromString("")
+		require.NoError(t, err)
+		assert.True(t, m.IsDefined())
+		assert.True(t, m.IsNil())
+	})
+
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, "12.50 SEK", NewMoney(1250, "SEK").String())
+		assert.Equal(t, "-0.05 USD", NewMoney(-5, "USD").String())
+		assert.Equal(t, "1000 JPY", NewMoney(1000, "JPY").String())
+		assert.Equal(t, "0.001 KWD", NewMoney(1, "KWD").String())
+		assert.Equal(t, "", NewMoneyFromPtr(nil, "SEK").String())
+	})
+
+	t.Run("Add and Sub", func(t *testing.T) {
+		sum, err := NewMoney(1250, "SEK").Add(NewMoney(250, "SEK"))
+		require.NoError(t, err)
+		assert.Equal(t, NewMoney(1500, "SEK"), sum)
+
+		diff, err := NewMoney(1250, "SEK").Sub(NewMoney(1500, "SEK"))
+		require.NoError(t, err)
+		assert.Equal(t, NewMoney(-250, "SEK"), diff)
+
+		_, err = NewMoney(1250, "SEK").Add(NewMoney(100, "EUR"))
+		assert.Error(t, err)
+
+		_, err = NewMoney(1250, "SEK").Sub(NewMoney(100, "EUR"))
+		assert.Error(t, err)
+
+		sum, err = NewMoney(1250, "SEK").Add(NewMoneyUndefined())
+		require.NoError(t, err)
+		assert.True(t, sum.IsNil())
+
+		_, err = NewMoney(math.MaxInt64, "SEK").Add(NewMoney(1, "SEK"))
+		assert.Error(t, err)
+
+		_, err = NewMoney(math.MinInt64, "SEK").Add(NewMoney(-1, "SEK"))
+		assert.Error(t, err)
+
+		_, err = NewMoney(math.MinInt64, "SEK").Sub(NewMoney(1, "SEK"))
+		assert.Error(t, err)
+
+		_, err = NewMoney(0, "SEK").Sub(NewMoney(math.MinInt64, "SEK"))
+		assert.Error(t, err)
+
+		diff, err = NewMoney(-1, "SEK").Sub(NewMoney(math.MinInt64, "SEK"))
+		require.NoError(t, err)
+		assert.Equal(t, NewMoney(math.MaxInt64, "SEK"), diff)
+	})
+
+	t.Run("MoneyFromMinor", func(t *testing.T) {
+		m, err := MoneyFromMinor(1250, "sek")
+		require.NoError(t, err)
+		assert.Equal(t, NewMoney(1250, "SEK"), m)
+
+		for _, currency := range []string{"", "SEKK", "S1K", "ABC", "XAU"} {
+			_, err := MoneyFromMinor(1, currency)
+			assert.Error(t, err, currency)
+		}
+
+		assert.NotPanics(t, func() { NewMoney(1, "") })
+		assert.Equal(t, "ABC", NewMoney(1, "abc").Currency())
+
+		var decoded Money
+		assert.Error(t, decoded.UnmarshalBinary(append(binary.BigEndian.AppendUint64([]byte{binaryDefined}, 1), "S1K"...)))
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		type invoice struct {
+			Total    Money `json:"total"`
+			Discount Money `json:"discount"`
+		}
+
+		data, err := json.Marshal(invoice{Total: NewMoney(1250, "SEK"), Discount: NewMoneyFromPtr(nil, "")})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"total":{"amount":1250,"currency":"SEK"},"discount":null}`, string(data))
+
+		var i invoice
+		require.NoError(t, json.Unmarshal(data, &i))
+		assert.Equal(t, NewMoney(1250, "SEK"), i.Total)
+		assert.True(t, i.Discount.IsDefined())
+		assert.True(t, i.Discount.IsNil())
+
+		assert.Error(t, json.Unmarshal([]byte(`{"total":{"amount":1250,"currency":"kronor"}}`), &i))
+	})
+
+	t.Run("YAML", func(t *testing.T) {
+		data, err := yaml.Marshal(map[string]Money{"total": NewMoney(1250, "SEK")})
+		require.NoError(t, err)
+
+		var out map[string]Money
+		require.NoError(t, yaml.Unmarshal(data, &out))
+		assert.Equal(t, NewMoney(1250, "SEK"), out["total"])
+	})
+
+	t.Run("Scan and Value", func(t *testing.T) {
+		value, err := NewMoney(1250, "SEK").Value()
+		require.NoError(t, err)
+		assert.Equal(t, "12.50 SEK", value)
+
+		var m Money
+		require.NoError(t, m.Scan([]byte("12.50 SEK")))
+		assert.Equal(t, NewMoney(1250, "SEK"), m)
+
+		require.NoError(t, m.Scan(nil))
+		assert.True(t, m.IsDefined())
+		assert.True(t, m.IsNil())
+	})
+
+	t.Run("ParseFromString", func(t *testing.T) {
+		value, err := ParseFromString("types.Money", "12.50 SEK")
+		require.NoError(t, err)
+		assert.Equal(t, NewMoney(1250, "SEK"), value)
+		assert.True(t, IsEmptyArray([]Money{}))
+	})
+}
+
+func TestMsgpack(t *testing.T) {
+	now := time.Date(2024, 3, 5, 14, 30, 15, 0, time.UTC)
+
+	t.Run("round trip", func(t *testing.T) {
+		tt := []struct {
+			name   string
+			value  msgpack.CustomEncoder
+			target msgpack.CustomDecoder
+		}{
+			{"bool", NewBool(true), &Bool{}},
+			{"bytes", NewBytes([]byte{0, 1, 2}), &Bytes{}},
+			{"bytes empty", NewBytes([]byte{}), &Bytes{}},
+			{"color", NewColor("#ffaa00"), &Color{}},
+			{"date", NewDate(now), &Date{}},
+			{"date time", NewDateTime(time.Date(2024, 1, 2, 15, 4, 5, 123456789, time.FixedZone("", 2*60*60))), &DateTime{}},
+			{"float64", NewFloat64(-12.75), &Float64{}},
+			{"int", NewInt(-42), &Int{}},
+			{"int16", NewInt16(-1234), &Int16{}},
+			{"int64", NewInt64(1 << 40), &Int64{}},
+			{"json", NewJSON(json.RawMessage(`{"a":1}`)), &JSON{}},
+			{"money", NewMoney(1250, "SEK"), &Money{}},
+			{"nullable", NewNullable(uint8(7)), &Nullable[uint8]{}},
+			{"percent", NewPercent(42.5), &Percent{}},
+			{"rich text", NewRichText("<p>hej</p>"), &RichText{}},
+			{"string", NewString("hello"), &String{}},
+			{"string empty", NewString(""), &String{}},
+			{"time", NewTime(now), &Time{}},
+			{"time seconds", NewTimeSeconds(now), &TimeSeconds{}},
+			{"timestamp", NewTimestamp(now), &Timestamp{}},
+			{"uuid", NewUUID(uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")), &UUID{}},
+			{"nil", NewStringFromPtr(nil), &String{}},
+			{"undefined", NewStringUndefined(), &String{}},
+			{"nullable nil", NewNullableFromPtr[uint8](nil), &Nullable[uint8]{}},
+			{"nullable undefined", Nullable[uint8]{}, &Nullable[uint8]{}},
+			{"timestamp undefined", NewTimestampUndefined(), &Timestamp{}},
+			{"uuid nil", NewUUIDFromPtr(nil), &UUID{}},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				data, err := msgpack.Marshal(tc.value)
+				require.NoError(t, err)
+
+				err = msgpack.Unmarshal(data, tc.target)
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.value, reflect.ValueOf(tc.target).Elem().Interface())
+			})
+		}
+	})
+
+	t.Run("timestamp keeps nanoseconds", func(t *testing.T) {
+		for _, value := range []Timestamp{
+			{underlying: time.Date(2024, 3, 5, 14, 30, 15, 123456789, time.UTC), isDefined: true},
+			NewTimestamp(now).EndOfDay(time.UTC),
+		} {
+			data, err := msgpack.Marshal(value)
+			require.NoError(t, err)
+
+			var decoded Timestamp
+			require.NoError(t, msgpack.Unmarshal(data, &decoded))
+			assert.Equal(t, value, decoded)
+		}
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		type person struct {
+			ID        UUID
+			Name      String
+			Nickname  String
+			Email     String
+			CreatedAt Timestamp
+		}
+
+		p := person{
+			ID:        NewRandomUUID(),
+			Name:      NewString("Alice"),
+			Nickname:  NewStringFromPtr(nil),
+			CreatedAt: NewTimestamp(now),
+		}
+
+		data, err := msgpack.Marshal(p)
+		require.NoError(t, err)
+
+		var decoded person
+		require.NoError(t, msgpack.Unmarshal(data, &decoded))
+		assert.Equal(t, p, decoded)
+		assert.True(t, decoded.Nickname.IsDefined())
+		assert.False(t, decoded.Email.IsDefined())
+	})
+
+	t.Run("native encodings", func(t *testing.T) {
+		data, err := msgpack.Marshal(NewInt(1))
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x01}, data)
+
+		data, err = msgpack.Marshal(NewStringFromPtr(nil))
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0xd4, 117, 1}, data)
+
+		var s String
+		require.NoError(t, s.DecodeMsgpack(msgpack.NewDecoder(bytes.NewReader([]byte{0xc0}))))
+		assert.Equal(t, NewStringFromPtr(nil), s)
+
+		data, err = msgpack.Marshal(NewTimestamp(now))
+		require.NoError(t, err)
+
+		var tm time.Time
+		require.NoError(t, msgpack.Unmarshal(data, &tm))
+		assert.True(t, now.Equal(tm))
+	})
+
+	t.Run("StringEnum", func(t *testing.T) {
+		data, err := msgpack.Marshal(NewString("deleted"))
+		require.NoError(t, err)
+
+		var status StringEnum[testStatus]
+		assert.Error(t, msgpack.Unmarshal(data, &status))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var i Int
+		assert.Error(t, msgpack.Unmarshal([]byte{0xd4, 0x01, 0x00}, &i))
+		assert.Error(t, msgpack.Unmarshal([]byte{0xd4, 117, 0x02}, &i))
+		assert.Error(t, msgpack.Unmarshal([]byte{0xa1, 'x'}, &i))
+	})
+}
+
+func TestMust(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		assert.Equal(t, NewInt(42), MustIntFromString("42"))
+		assert.Equal(t, NewDate(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)), MustDateFromString("2024-01-02"))
+		assert.Equal(t, NewTimestamp(time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)), MustTimestampFromString("2024-01-02T15:04:05Z"))
+		assert.Equal(t, "123e4567-e89b-12d3-a456-426614174000", MustUUIDFromString("123e4567-e89b-12d3-a456-426614174000").String())
+		assert.Equal(t, NewMoney(1250, "SEK"), MustMoneyFromString("12.50 SEK"))
+		assert.True(t, MustBoolFromString("").IsNil())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		assert.Panics(t, func() { MustBoolFromString("maybe") })
+		assert.Panics(t, func() { MustDateFromString("not a date") })
+		assert.Panics(t, func() { MustFloat64FromString("abc") })
+		assert.Panics(t, func() { MustIntFromString("abc") })
+		assert.Panics(t, func() { MustTimeFromString("25:99") })
+		assert.Panics(t, func() { MustTimestampFromString("yesterday") })
+		assert.Panics(t, func() { MustUUIDFromString("nope") })
+	})
+}
+
+func TestNullable(t *testing.T) {
+	t.Run("States", func(t *testing.T) {
+		value := 42
+
+		assert.True(t, NewNullable(value).IsDefined())
+		assert.False(t, NewNullable(value).IsNil())
+		assert.Equal(t, 42, NewNullable(value).Underlying())
+
+		assert.True(t, NewNullableFromPtr(&value).IsDefined())
+		assert.False(t, NewNullableFromPtr(&value).IsNil())
+
+		assert.True(t, NewNullableFromPtr[int](nil).IsDefined())
+		assert.True(t, NewNullableFromPtr[int](nil).IsNil())
+		assert.Nil(t, NewNullableFromPtr[int](nil).UnderlyingPtr())
+
+		assert.False(t, NewNullableUndefined[int]().IsDefined())
+		assert.True(t, NewNullableUndefined[int]().IsNil())
+		assert.Nil(t, NewNullableUndefined[int]().Ptr())
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		type Point struct {
+			X, Y int
+		}
+
+		type Shape struct {
+			Center Nullable[Point]
+			Corner Nullable[Point]
+			Origin Nullable[Point]
+		}
+
+		var shape Shape
+		err := json.Unmarshal([]byte(`{"Center":{"X":1,"Y":2},"Corner":null}`), &shape)
+		require.NoError(t, err)
+
+		assert.Equal(t, Point{X: 1, Y: 2}, shape.Center.Underlying())
+		assert.True(t, shape.Corner.IsDefined())
+		assert.True(t, shape.Corner.IsNil())
+		assert.False(t, shape.Origin.IsDefined())
+
+		jsonBytes, err := json.Marshal(shape)
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{"Center":{"X":1,"Y":2},"Corner":null,"Origin":null}`, string(jsonBytes))
+	})
+
+	t.Run("Scan and Value", func(t *testing.T) {
+		var n Nullable[int64]
+		require.NoError(t, n.Scan(int64(7)))
+		assert.Equal(t, int64(7), n.Underlying())
+
+		value, err := n.Value()
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), value)
+
+		require.NoError(t, n.Scan(nil))
+		assert.True(t, n.IsDefined())
+		assert.True(t, n.IsNil())
+
+		value, err = n.Value()
+		require.NoError(t, err)
+		assert.Nil(t, value)
+	})
+}
+
+func TestParseFromString(t *testing.T) {
+	t.Run("built-in types", func(t *testing.T) {
+		value, err := ParseFromString("types.Int", "42")
+		require.NoError(t, err)
+		assert.Equal(t, NewInt(42), value)
+
+		_, err = ParseFromString("types.Unknown", "42")
+		assert.EqualError(t, err, "invalid type: types.Unknown")
+	})
+
+	t.Run("registered type", func(t *testing.T) {
+		RegisterType("types.OrgNumber", func(value string) (any, error) {
+			if value == "" {
+				return NewStringFromPtr(nil), nil
+			}
+
+			if len(value) != 11 {
+				return nil, errors.New("invalid org number")
+			}
+
+			return NewString(value), nil
+		})
+		t.Cleanup(func() {
+			parsersMu.Lock()
+			defer parsersMu.Unlock()
+
+			delete(parsers, "types.OrgNumber")
+		})
+
+		value, err := ParseFromString("types.OrgNumber", "556677-8899")
+		require.NoError(t, err)
+		assert.Equal(t, NewString("556677-8899"), value)
+
+		_, err = ParseFromString("types.OrgNumber", "1")
+		assert.EqualError(t, err, "invalid org number")
+
+		null, err := TypedNull("types.OrgNumber")
+		require.NoError(t, err)
+		assert.Equal(t, NewStringFromPtr(nil), null)
+
+		assert.Panics(t, func() {
+			RegisterType("types.OrgNumber", func(value string) (any, error) { return nil, nil })
+		})
+	})
+
+	t.Run("nil parser", func(t *testing.T) {
+		assert.Panics(t, func() { RegisterType("types.Nil", nil) })
+	})
+
+	t.Run("RegisterType is safe for concurrent use", func(t *testing.T) {
+		t.Cleanup(func() {
+			parsersMu.Lock()
+			defer parsersMu.Unlock()
+
+			delete(parsers, "types.Concurrent")
+		})
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+
+			for i := 0; i < 100; i++ {
+				_, _ = ParseFromString("types.Concurrent", "1")
+			}
+		}()
+
+		RegisterType("types.Concurrent", func(value string) (any, error) { return NewString(value), nil })
+
+		<-done
+	})
+}
+
+func TestPercent(t *testing.T) {
+	t.Run("PercentFromString", func(t *testing.T) {
+		tt := []struct {
+			input    string
+			expected float64
+			wantErr  bool
+		}{
+			{input: "42.5", expected: 42.5},
+			{input: "42.5%", expected: 42.5},
+			{input: " 150 % ", expected: 150},
+			{input: "-3%", expected: -3},
+			{input: "%", wantErr: true},
+			{input: "abc%", wantErr: true},
+			{input: "NaN", wantErr: true},
+			{input: "Inf%", wantErr: true},
+			{input: "-Infinity", wantErr: true},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.input, func(t *testing.T) {
+				p, err := PercentFromString(tc.input)
+				if tc.wantErr {
+					assert.Error(t, err)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, p.Percent())
+			})
+		}
+
+		p, err := PercentFromString("")
+		require.NoError(t, err)
+		assert.True(t, p.IsNil())
+	})
+
+	t.Run("String and Fraction", func(t *testing.T) {
+		assert.Equal(t, "42.5%", NewPercent(42.5).String())
+		assert.Equal(t, "150%", NewPercent(150).String())
+		assert.Equal(t, "33.33%", NewPercentFromFraction(1.0/3).String())
+		assert.Equal(t, "", NewPercentFromPtr(nil).String())
+		assert.InDelta(t, 0.425, NewPercent(42.5).Fraction(), 1e-12)
+		assert.InDelta(t, 1.5, NewPercent(150).Fraction(), 1e-12)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		type grade struct {
+			Weight Percent `json:"weight"`
+		}
+
+		data, err := json.Marshal(grade{Weight: NewPercent(42.5)})
+		require.NoError(t, err)
+		assert.Equal(t, `{"weight":42.5}`, string(data))
+
+		SetPercentMarshalMode(PercentFraction)
+		t.Cleanup(func() { SetPercentMarshalMode(PercentWhole) })
+
+		data, err = json.Marshal(grade{Weight: NewPercent(42.5)})
+		require.NoError(t, err)
+		assert.Equal(t, `{"weight":0.425}`, string(data))
+
+		var g grade
+		require.NoError(t, json.Unmarshal([]byte(`{"weight":0.25}`), &g))
+		assert.Equal(t, NewPercent(25), g.Weight)
+
+		require.NoError(t, json.Unmarshal([]byte(`{"weight":null}`), &g))
+		assert.True(t, g.Weight.IsDefined())
+		assert.True(t, g.Weight.IsNil())
+	})
+
+	t.Run("Scan and Value", func(t *testing.T) {
+		var p Percent
+		require.NoError(t, p.Scan(float64(87.5)))
+		assert.Equal(t, NewPercent(87.5), p)
+
+		value, err := p.Value()
+		require.NoError(t, err)
+		assert.Equal(t, 87.5, value)
+
+		require.NoError(t, p.Scan(nil))
+		assert.True(t, p.IsNil())
+	})
+
+	t.Run("WithRange", func(t *testing.T) {
+		assert.NoError(t, NewPercent(100).Validate(WithRange(0, 100)))
+		assert.Error(t, NewPercent(150).Validate(WithRange(0, 100)))
+	})
+
+	t.Run("ParseFromString", func(t *testing.T) {
+		value, err := ParseFromString("types.Percent", "12%")
+		require.NoError(t, err)
+		assert.Equal(t, NewPercent(12), value)
+		assert.True(t, IsEmptyArray([]Percent{}))
+	})
+
+	t.Run("PercentFromSwedishString", func(t *testing.T) {
+		tt := []struct {
+			input    string
+			expected float64
+			wantErr  bool
+		}{
+			{input: "85,5%", expected: 85.5},
+			{input: "85,5", expected: 85.5},
+			{input: " 85,5 % ", expected: 85.5},
+			{input: "100%", expected: 100},
+			{input: "0", expected: 0},
+			{input: "85.5%", wantErr: true},
+			{input: "1,000,5", wantErr: true},
+			{input: "100,5%", wantErr: true},
+			{input: "-1%", wantErr: true},
+			{input: "%", wantErr: true},
+			{input: "åttiofem", wantErr: true},
+			{input: "NaN", wantErr: true},
+			{input: "+Inf%", wantErr: true},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.input, func(t *testing.T) {
+				p, err := PercentFromSwedishString(tc.input)
+				if tc.wantErr {
+					assert.Error(t, err)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, NewPercent(tc.expected), p)
+			})
+		}
+
+		p, err := PercentFromSwedishString("")
+		require.NoError(t, err)
+		assert.True(t, p.IsNil())
+	})
+}
+
 //nolint:lll
 func TestRichText(t *testing.T) {
 	t.Run("Unmarshal", func(t *testing.T) {
 		tt := []struct {
-			content, expected string
+			content, expected string
+		}{
+			{
+				content:  "<p>Paragraf</p><h1>Stor rubrik</h1><h2>Medium rubrik</h2><h3>Liten rubrik</h3><ul><li><p>punkt</p></li><li><p>lista</p></li></ul><ol><li><p>numrerad </p></li><li><p>lista</p><pre><code>Kodblock</code></pre></li></ol><p><strong>FET </strong><em>KURSIV </em> <u>UNDERSTRYKNING</u> <s>GENOMSTRUKEN</s> <code>KODD</code> <a target=\\\"_blank\\\" rel=\\\"noopener noreferrer nofollow\\\" href=\\\"https://google.com\\\">länk till google</a></p><p></p><img src=\\\"https://fileserver.develop.meitner.se/v1/file/efe378e5-e263-438c-841c-07ab20c60bc0.png\\\">",
+				expected: "Paragraf\n\nStor rubrik\n\nMedium rubrik\n\nLiten rubrik\n\npunkt\n\nlista\n\nnumrerad \n\nlista\n\nKodblock\n\nFET KURSIV  UNDERSTRYKNING GENOMSTRUKEN KODD länk till google\n\n",
+			},
+			{
+				content:  "<ul><li><p>punkt</p></li><li><p>lista</p></li></ul>",
+				expected: "punkt\n\nlista",
+			},
+			{
+				content:  "<p>hej</p><p>på dig</p><p></p>",
+				expected: "hej\n\npå dig",
+			},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.content, func(t *testing.T) {
+				input := fmt.Sprintf(`{"content":"%s"}`, tc.content)
+
+				var output RichText
+				err := json.Unmarshal([]byte(input), &output)
+				require.NoError(t, err)
+
+				text, err := output.Text()
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expected, text)
+			})
+		}
+	})
+
+	t.Run("WordCount and CharCount", func(t *testing.T) {
+		tt := []struct {
+			content      string
+			words, chars int
+		}{
+			{content: "<p>Hej på dig</p>", words: 3, chars: 10},
+			{content: "<p>Tom &amp; Jerry</p>", words: 2, chars: 11},
+			{content: "<p>Ett</p><p>två – tre</p>", words: 3, chars: 14},
+			{content: "<p>😀 emoji</p>", words: 1, chars: 7},
+			{content: "<p></p>", words: 0, chars: 0},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.content, func(t *testing.T) {
+				words, err := NewRichText(tc.content).WordCount()
+				require.NoError(t, err)
+				assert.Equal(t, tc.words, words)
+
+				chars, err := NewRichText(tc.content).CharCount()
+				require.NoError(t, err)
+				assert.Equal(t, tc.chars, chars)
+			})
+		}
+
+		for _, richText := range []RichText{NewRichTextFromPtr(nil), NewRichTextUndefined()} {
+			words, err := richText.WordCount()
+			require.NoError(t, err)
+			assert.Equal(t, 0, words)
+
+			chars, err := richText.CharCount()
+			require.NoError(t, err)
+			assert.Equal(t, 0, chars)
+		}
+	})
+
+	t.Run("Links", func(t *testing.T) {
+		content := "<p><strong>FET </strong><a target=\"_blank\" rel=\"noopener noreferrer nofollow\" href=\"https://google.com\">länk till google</a></p><p><a href=\"https://meitner.se\">meitner</a> <a href=\"https://google.com\">google igen</a> <a>utan href</a></p><img src=\"https://fileserver.develop.meitner.se/v1/file/efe378e5-e263-438c-841c-07ab20c60bc0.png\">"
+
+		links, err := NewRichText(content).Links()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"https://google.com", "https://meitner.se", "https://google.com"}, links)
+
+		links, err = NewRichText(content).UniqueLinks()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"https://google.com", "https://meitner.se"}, links)
+
+		links, err = NewRichTextFromPtr(nil).Links()
+		require.NoError(t, err)
+		assert.Equal(t, []string{}, links)
+
+		links, err = NewRichTextUndefined().UniqueLinks()
+		require.NoError(t, err)
+		assert.Equal(t, []string{}, links)
+	})
+
+	t.Run("ImageSources", func(t *testing.T) {
+		content := "<p>Paragraf</p><p></p><img src=\"https://fileserver.develop.meitner.se/v1/file/efe378e5-e263-438c-841c-07ab20c60bc0.png\">"
+
+		sources, err := NewRichText(content).ImageSources()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"https://fileserver.develop.meitner.se/v1/file/efe378e5-e263-438c-841c-07ab20c60bc0.png"}, sources)
+
+		sources, err = NewRichTextFromPtr(nil).ImageSources()
+		require.NoError(t, err)
+		assert.Equal(t, []string{}, sources)
+
+		rewritten, err := NewRichText(content).RewriteImageSources(func(src string) string {
+			return strings.Replace(src, "https://fileserver.develop.meitner.se", "https://cdn.meitner.se", 1)
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "<p>Paragraf</p><p></p><img src=\"https://cdn.meitner.se/v1/file/efe378e5-e263-438c-841c-07ab20c60bc0.png\"/>", rewritten.RichText())
+
+		sources, err = rewritten.ImageSources()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"https://cdn.meitner.se/v1/file/efe378e5-e263-438c-841c-07ab20c60bc0.png"}, sources)
+
+		rewritten, err = NewRichTextUndefined().RewriteImageSources(strings.ToUpper)
+		require.NoError(t, err)
+		assert.False(t, rewritten.IsDefined())
+	})
+
+	t.Run("Markdown", func(t *testing.T) {
+		tt := []struct {
+			name, content, expected string
+		}{
+			{
+				name:     "formatting",
+				content:  "<p><strong>FET </strong><em>KURSIV </em> <u>UNDERSTRYKNING</u> <s>GENOMSTRUKEN</s> <code>KODD</code> <a href=\"https://google.com\">länk till google</a></p>",
+				expected: "**FET** _KURSIV_  UNDERSTRYKNING ~~GENOMSTRUKEN~~ `KODD` [länk till google](https://google.com)",
+			},
+			{
+				name:     "headings",
+				content:  "<h1>Stor rubrik</h1><h2>Medium rubrik</h2><h3>Liten rubrik</h3><p>Paragraf</p>",
+				expected: "# Stor rubrik\n\n## Medium rubrik\n\n### Liten rubrik\n\nParagraf",
+			},
+			{
+				name:     "lists",
+				content:  "<ul><li><p>punkt</p></li><li><p>lista</p></li></ul><ol><li><p>numrerad </p></li><li><p>lista</p></li></ol>",
+				expected: "- punkt\n- lista\n\n1. numrerad\n2. lista",
+			},
+			{
+				name:     "nested lists",
+				content:  "<ul><li><p>a</p><ol><li><p>b</p><ul><li>c</li></ul></li><li>d</li></ol></li><li>e</li></ul>",
+				expected: "- a\n  1. b\n     - c\n  2. d\n- e",
+			},
+			{
+				name:     "code block",
+				content:  "<p>Kod:</p><pre><code>func main() {\n\treturn\n}\n</code></pre>",
+				expected: "Kod:\n\n```\nfunc main() {\n\treturn\n}\n```",
+			},
+			{
+				name:     "empty paragraphs",
+				content:  "<p>hej</p><p></p><p>på dig</p><p> </p>",
+				expected: "hej\n\npå dig",
+			},
+			{
+				name:     "plain text",
+				content:  "hej",
+				expected: "hej",
+			},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				markdown, err := NewRichText(tc.content).Markdown()
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expected, markdown)
+			})
+		}
+	})
+
+	t.Run("Sanitize", func(t *testing.T) {
+		tt := []struct {
+			content, expected string
+		}{
+			{
+				content:  "<p>Hello <strong>world</strong></p>",
+				expected: "<p>Hello <strong>world</strong></p>",
+			},
+			{
+				content:  "<p>Hello</p><script>alert('xss')</script>",
+				expected: "<p>Hello</p>",
+			},
+			{
+				content:  "<style>p { color: red; }</style><iframe src=\"https://evil.com\"></iframe><p>Hello</p>",
+				expected: "<p>Hello</p>",
+			},
+			{
+				content:  "<p onclick=\"alert('xss')\">Hello <img src=\"https://example.com/a.png\" onerror=\"alert('xss')\"></p>",
+				expected: "<p>Hello <img src=\"https://example.com/a.png\"/></p>",
+			},
+			{
+				content:  "<div><span>Hello</span></div><!-- comment -->",
+				expected: "Hello",
+			},
+			{
+				content:  "<a href=\"https://google.com\" target=\"_blank\">google</a>",
+				expected: "<a href=\"https://google.com\" target=\"_blank\" rel=\"noopener\">google</a>",
+			},
+			{
+				content:  "<a href=\"https://google.com\" rel=\"noreferrer nofollow\">google</a>",
+				expected: "<a href=\"https://google.com\" rel=\"noopener noreferrer nofollow\">google</a>",
+			},
+			{
+				content:  "<a href=\"javascript:alert('xss')\">click</a>",
+				expected: "<a rel=\"noopener\">click</a>",
+			},
+			{
+				content:  "1 &lt; 2",
+				expected: "1 &lt; 2",
+			},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.content, func(t *testing.T) {
+				sanitized, err := NewRichText(tc.content).Sanitize()
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expected, sanitized.RichText())
+			})
+		}
+
+		t.Run("Scan", func(t *testing.T) {
+			var richText RichText
+			require.NoError(t, richText.Scan([]byte("<p onclick=\"alert('xss')\">Hello</p><script>alert('xss')</script>")))
+			assert.Equal(t, "<p>Hello</p>", richText.RichText())
+
+			jsonBytes, err := json.Marshal(richText)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"content":"<p>Hello</p>","text":"Hello"}`, string(jsonBytes))
+		})
+
+		t.Run("Scan changes stored content", func(t *testing.T) {
+			// Content stored by an older version or by another service is rewritten when it is read,
+			// so writing a scanned value back changes the row even if the user did not touch it.
+			stored := `<p class="lead">Hello</p><blockquote>Quote</blockquote>`
+
+			var richText RichText
+			require.NoError(t, richText.Scan(stored))
+			assert.Equal(t, "<p>Hello</p>Quote", richText.RichText())
+
+			value, err := richText.Value()
+			require.NoError(t, err)
+			assert.NotEqual(t, stored, value)
+		})
+
+		t.Run("RichTextFromString and UnmarshalYAML", func(t *testing.T) {
+			richText, err := RichTextFromString("<p>Hello</p><script>alert('xss')</script>")
+			require.NoError(t, err)
+			assert.Equal(t, "<p>Hello</p>", richText.RichText())
+
+			require.NoError(t, yaml.Unmarshal([]byte("content: <p>Hello</p><script>alert('xss')</script>"), &richText))
+			assert.Equal(t, "<p>Hello</p>", richText.RichText())
+		})
+
+		t.Run("UnmarshalJSON", func(t *testing.T) {
+			var richText RichText
+			err := json.Unmarshal([]byte(`{"content":"<p>Hello</p><script>alert('xss')</script>"}`), &richText)
+			require.NoError(t, err)
+
+			assert.Equal(t, "<p>Hello</p>", richText.RichText())
+		})
+
+		t.Run("AllowedTags", func(t *testing.T) {
+			tags := RichTextAllowedTags()
+			tags["blockquote"] = []string{}
+			tags["p"] = append(tags["p"], "class")
+
+			SetRichTextAllowedTags(tags)
+			defer SetRichTextAllowedTags(nil)
+
+			// The tags are copied, so changing the map afterwards has no effect
+			delete(tags, "blockquote")
+
+			sanitized, err := NewRichText(`<blockquote>Quote</blockquote><p class="lead">Hello</p>`).Sanitize()
+			require.NoError(t, err)
+			assert.Equal(t, `<blockquote>Quote</blockquote><p class="lead">Hello</p>`, sanitized.RichText())
+
+			SetRichTextAllowedTags(nil)
+
+			sanitized, err = NewRichText(`<blockquote>Quote</blockquote>`).Sanitize()
+			require.NoError(t, err)
+			assert.Equal(t, "Quote", sanitized.RichText())
+			assert.NotContains(t, RichTextAllowedTags(), "blockquote")
+		})
+	})
+
+	t.Run("CountElements", func(t *testing.T) {
+		content := NewRichText("<h1>Rubrik</h1><p>Paragraf med <a href=\"https://meitner.se\">länk</a></p><p></p><img src=\"https://fileserver.develop.meitner.se/v1/file/efe378e5-e263-438c-841c-07ab20c60bc0.png\">")
+
+		tt := []struct {
+			tagName  string
+			expected int
+		}{
+			{"img", 1},
+			{"a", 1},
+			{"p", 2},
+			{"H1", 1},
+			{"h2", 0},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.tagName, func(t *testing.T) {
+				count, err := content.CountElements(tc.tagName)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, count)
+			})
+		}
+
+		images, err := content.CountImages()
+		require.NoError(t, err)
+		assert.Equal(t, 1, images)
+
+		images, err = NewRichTextFromPtr(nil).CountImages()
+		require.NoError(t, err)
+		assert.Equal(t, 0, images)
+	})
+
+	t.Run("SetRichTextMarshalMode", func(t *testing.T) {
+		richText := NewRichText("<p>Hej <strong>världen</strong></p>")
+
+		data, err := json.Marshal(richText)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"content":"<p>Hej <strong>världen</strong></p>","text":"Hej världen"}`, string(data))
+
+		SetRichTextMarshalMode(RichTextContentOnly)
+		t.Cleanup(func() { SetRichTextMarshalMode(RichTextEnvelope) })
+
+		data, err = json.Marshal(richText)
+		require.NoError(t, err)
+		assert.JSONEq(t, `"<p>Hej <strong>världen</strong></p>"`, string(data))
+
+		data, err = json.Marshal(NewRichTextFromPtr(nil))
+		require.NoError(t, err)
+		assert.Equal(t, `null`, string(data))
+	})
+
+	t.Run("UnmarshalJSON accepts both modes", func(t *testing.T) {
+		for _, input := range []string{
+			`{"content":"<p>Hej</p>","text":"Hej"}`,
+			`"<p>Hej</p>"`,
+		} {
+			var richText RichText
+			require.NoError(t, json.Unmarshal([]byte(input), &richText))
+			assert.Equal(t, "<p>Hej</p>", richText.RichText())
+		}
+
+		var richText RichText
+		assert.Error(t, json.Unmarshal([]byte(`42`), &richText))
+	})
+
+	t.Run("UnmarshalJSON bare string", func(t *testing.T) {
+		var payload struct {
+			Body RichText `json:"body"`
+		}
+
+		require.NoError(t, json.Unmarshal([]byte(`{"body": "  <p>hi <em>there</em></p> "}`), &payload))
+		assert.True(t, payload.Body.IsDefined())
+		assert.Equal(t, "<p>hi <em>there</em></p>", payload.Body.RichText())
+
+		require.NoError(t, json.Unmarshal([]byte(`{"body": ""}`), &payload))
+		assert.False(t, payload.Body.IsNil())
+		assert.Equal(t, "", payload.Body.RichText())
+
+		require.NoError(t, json.Unmarshal([]byte(`{"body": null}`), &payload))
+		assert.True(t, payload.Body.IsNil())
+
+		assert.Error(t, json.Unmarshal([]byte(`{"body": "unterminated}`), &payload))
+		assert.Error(t, json.Unmarshal([]byte(`{"body": {"content": 1}}`), &payload))
+	})
+
+	t.Run("IsEmpty", func(t *testing.T) {
+		tt := []struct {
+			name     string
+			input    RichText
+			expected bool
+		}{
+			{name: "text", input: NewRichText("<p>Hej</p>"), expected: false},
+			{name: "empty paragraph", input: NewRichText("<p></p>"), expected: true},
+			{name: "whitespace and breaks", input: NewRichText("<p> &nbsp;<br></p><p></p>"), expected: true},
+			{name: "empty string", input: NewRichText(""), expected: true},
+			{name: "nested text", input: NewRichText("<ul><li><strong>x</strong></li></ul>"), expected: false},
+			{name: "nil", input: NewRichTextFromPtr(nil), expected: true},
+			{name: "undefined", input: NewRichTextUndefined(), expected: true},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				assert.Equal(t, tc.expected, tc.input.IsEmpty())
+			})
+		}
+	})
+
+	t.Run("ValidateMaxChars", func(t *testing.T) {
+		// "Hälsning från Åre" is 17 runes but more bytes and much more HTML
+		content := NewRichText(`<p><strong>Hälsning</strong> från <a href="https://example.com">Åre</a></p>`)
+
+		tt := []struct {
+			name    string
+			max     int
+			wantErr string
+		}{
+			{name: "above", max: 16, wantErr: "text length 17 exceeds the max length of 16 characters"},
+			{name: "at", max: 17},
+			{name: "below", max: 18},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				err := content.ValidateMaxChars(tc.max)
+				if tc.wantErr != "" {
+					assert.EqualError(t, err, tc.wantErr)
+					return
+				}
+
+				assert.NoError(t, err)
+			})
+		}
+
+		assert.NoError(t, NewRichText("<p>🎉🎉</p>").ValidateMaxChars(2))
+		assert.Error(t, NewRichText("<p>🎉🎉🎉</p>").ValidateMaxChars(2))
+		assert.NoError(t, NewRichTextFromPtr(nil).ValidateMaxChars(0))
+		assert.NoError(t, NewRichTextUndefined().ValidateMaxChars(0))
+	})
+
+	t.Run("Preview", func(t *testing.T) {
+		note := NewRichText("<p>Hello <strong>world</strong>!</p><p>Second paragraph here</p>")
+
+		tt := []struct {
+			maxRunes int
+			expected string
+		}{
+			{100, "Hello world! Second paragraph here"},
+			{34, "Hello world! Second paragraph here"},
+			{33, "Hello world! Second paragraph…"},
+			{14, "Hello world!…"},
+			{13, "Hello world!…"},
+			{12, "Hello…"},
+			{4, "Hel…"},
+			{1, "…"},
+			{0, ""},
+		}
+
+		for _, tc := range tt {
+			t.Run(fmt.Sprint(tc.maxRunes), func(t *testing.T) {
+				preview, err := note.Preview(tc.maxRunes)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, preview)
+				assert.LessOrEqual(t, len([]rune(preview)), tc.maxRunes)
+			})
+		}
+
+		preview, err := NewRichText("<p>Åsa äter ärtsoppa</p>").Preview(10)
+		require.NoError(t, err)
+		assert.Equal(t, "Åsa äter…", preview)
+
+		preview, err = NewRichTextFromPtr(nil).Preview(10)
+		require.NoError(t, err)
+		assert.Equal(t, "", preview)
+
+		preview, err = NewRichTextUndefined().Preview(10)
+		require.NoError(t, err)
+		assert.Equal(t, "", preview)
+	})
+}
+
+func TestSnapshot(t *testing.T) {
+	type address struct {
+		Street String
+		City   String
+	}
+
+	type audit struct {
+		CreatedAt Timestamp
+	}
+
+	type person struct {
+		audit
+		ID       UUID
+		Name     String
+		Nickname String
+		Email    String
+		Age      Int
+		Score    Float64
+		Bio      RichText
+		Level    Nullable[uint8]
+		Rating   *Float64
+		Alias    *String
+		Home     address
+		Work     *address
+		Previous *address
+		Note     string
+		internal String
+	}
+
+	p := person{
+		audit:    audit{CreatedAt: NewTimestamp(time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC))},
+		ID:       NewUUID(uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")),
+		Name:     NewString(`Alice "Ally" Andersson`),
+		Nickname: NewStringFromPtr(nil),
+		Age:      NewInt(30),
+		Score:    NewFloat64(4.125),
+		Bio:      NewRichText("<p>Hej</p>"),
+		Level:    NewNullable(uint8(3)),
+		Rating:   NewFloat64(0.1234).Ptr(),
+		Home:     address{Street: NewString("Storgatan 1")},
+		Work:     &address{City: NewString("Lund")},
+		Note:     "not a type",
+		internal: NewString("ignored"),
+	}
+
+	expected := `CreatedAt = "2024-05-01T12:30:00Z"
+ID = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+Name = "Alice \"Ally\" Andersson"
+Nickname = nil
+Email = undefined
+Age = "30"
+Score = "4.125"
+Bio = "<p>Hej</p>"
+Level = 3
+Rating = "0.1234"
+Alias = undefined
+Home.Street = "Storgatan 1"
+Home.City = undefined
+Work.Street = undefined
+Work.City = "Lund"
+`
+
+	assert.Equal(t, expected, Snapshot(p))
+	assert.Equal(t, expected, Snapshot(&p))
+	assert.Equal(t, "", Snapshot("not a struct"))
+}
+
+func TestSortAny(t *testing.T) {
+	t.Run("Int", func(t *testing.T) {
+		values := []any{NewInt(3), NewIntFromPtr(nil), NewInt(-1), NewInt(2)}
+		require.NoError(t, SortAny(values))
+		assert.Equal(t, []any{NewIntFromPtr(nil), NewInt(-1), NewInt(2), NewInt(3)}, values)
+	})
+
+	t.Run("Timestamp", func(t *testing.T) {
+		first := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+		second := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+		third := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+		values := []any{NewTimestamp(third), NewTimestamp(first), NewTimestampUndefined(), NewTimestamp(second)}
+		require.NoError(t, SortAny(values))
+		assert.Equal(t, []any{NewTimestampUndefined(), NewTimestamp(first), NewTimestamp(second), NewTimestamp(third)}, values)
+	})
+
+	t.Run("mixed types", func(t *testing.T) {
+		values := []any{NewInt(2), NewInt64(1)}
+		assert.Error(t, SortAny(values))
+		assert.Equal(t, []any{NewInt(2), NewInt64(1)}, values)
+
+		assert.Error(t, SortAny([]any{NewJSON(json.RawMessage(`1`))}))
+		assert.Error(t, SortAny([]any{NewMoney(100, "SEK"), NewMoney(100, "EUR")}))
+	})
+
+	t.Run("Compare", func(t *testing.T) {
+		result, err := NewString("a").Compare(NewString("b"))
+		require.NoError(t, err)
+		assert.Equal(t, -1, result)
+
+		result, err = NewBool(true).Compare(NewBool(false))
+		require.NoError(t, err)
+		assert.Equal(t, 1, result)
+
+		result, err = NewDate(time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC)).Compare(NewDate(time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)))
+		require.NoError(t, err)
+		assert.Equal(t, 0, result)
+
+		_, err = NewString("a").Compare("a")
+		assert.Error(t, err)
+	})
+
+	t.Run("pointers", func(t *testing.T) {
+		one, two := NewInt(1).Ptr(), NewInt(2).Ptr()
+
+		values := []any{two, (*Int)(nil), one}
+		require.NoError(t, SortAny(values))
+		assert.Equal(t, []any{(*Int)(nil), one, two}, values)
+		assert.Same(t, one, values[1])
+
+		assert.Error(t, SortAny([]any{one, NewInt(2)}))
+		assert.Error(t, SortAny([]any{nil}))
+	})
+}
+
+func TestString(t *testing.T) {
+	t.Run("CompareCollated", func(t *testing.T) {
+		assert.Equal(t, 1, NewString("ä").CompareCollated(NewString("z"), language.Swedish))
+		assert.Equal(t, -1, NewString("z").CompareCollated(NewString("ä"), language.Swedish))
+		assert.Equal(t, 0, NewString("å").CompareCollated(NewString("å"), language.Swedish))
+		assert.Equal(t, -1, NewStringFromPtr(nil).CompareCollated(NewString("a"), language.Swedish))
+		assert.Equal(t, 1, NewString("a").CompareCollated(NewStringUndefined(), language.Swedish))
+		assert.Equal(t, 0, NewStringFromPtr(nil).CompareCollated(NewStringUndefined(), language.Swedish))
+	})
+
+	t.Run("CollateStrings", func(t *testing.T) {
+		s := []String{NewString("ö"), NewString("z"), NewString("ä"), NewStringFromPtr(nil), NewString("å"), NewString("a")}
+
+		CollateStrings(s, language.Swedish)
+
+		assert.Equal(t, []string{"", "a", "z", "å", "ä", "ö"}, []string{
+			s[0].String(), s[1].String(), s[2].String(), s[3].String(), s[4].String(), s[5].String(),
+		})
+		assert.True(t, s[0].IsNil())
+	})
+
+	t.Run("StringOrDefault", func(t *testing.T) {
+		assert.Equal(t, "set", StringOrDefault(NewString("set"), "default"))
+		assert.Equal(t, "default", StringOrDefault(NewStringFromPtr(nil), "default"))
+		assert.Equal(t, "default", StringOrDefault(NewStringUndefined(), "default"))
+	})
+
+	t.Run("Len and Truncate", func(t *testing.T) {
+		tt := []struct {
+			input        string
+			n            int
+			len          int
+			truncated    string
+			withEllipsis string
+		}{
+			{input: "Åsa Öberg", n: 5, len: 9, truncated: "Åsa Ö", withEllipsis: "Åsa …"},
+			{input: "😀😃😄", n: 2, len: 3, truncated: "😀😃", withEllipsis: "😀…"},
+			{input: "café", n: 4, len: 4, truncated: "café", withEllipsis: "café"},
+			{input: "café", n: 10, len: 4, truncated: "café", withEllipsis: "café"},
+			{input: "café", n: 0, len: 4, truncated: "", withEllipsis: ""},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.input, func(t *testing.T) {
+				s := NewString(tc.input)
+				assert.Equal(t, tc.len, s.Len())
+				assert.Equal(t, NewString(tc.truncated), s.Truncate(tc.n))
+				assert.Equal(t, NewString(tc.withEllipsis), s.TruncateWithEllipsis(tc.n))
+			})
+		}
+
+		assert.Equal(t, 0, NewStringFromPtr(nil).Len())
+		assert.Equal(t, NewStringFromPtr(nil), NewStringFromPtr(nil).Truncate(1))
+		assert.Equal(t, NewStringUndefined(), NewStringUndefined().TruncateWithEllipsis(1))
+	})
+
+	t.Run("IsEmpty", func(t *testing.T) {
+		assert.False(t, NewString("a").IsEmpty())
+		assert.False(t, NewString(" a ").IsEmpty())
+		assert.True(t, NewString("").IsEmpty())
+		assert.True(t, NewString(" \t\n").IsEmpty())
+		assert.False(t, NewString(" \t\n").IsNil())
+		assert.True(t, NewStringFromPtr(nil).IsEmpty())
+		assert.True(t, NewStringUndefined().IsEmpty())
+	})
+
+	t.Run("Matches", func(t *testing.T) {
+		courseCode := regexp.MustCompile(`^[A-Z]{3}\d{3}$`)
+
+		assert.True(t, NewString("MAT101").Matches(courseCode))
+		assert.False(t, NewString("mat101").Matches(courseCode))
+		assert.False(t, NewString("").Matches(courseCode))
+		assert.False(t, NewStringFromPtr(nil).Matches(regexp.MustCompile(`.*`)))
+		assert.False(t, NewStringUndefined().Matches(regexp.MustCompile(`.*`)))
+	})
+
+	t.Run("Normalize", func(t *testing.T) {
+		composed := NewString("Åsa Öberg")
+		decomposed := NewString("Åsa Öberg")
+		require.NotEqual(t, composed, decomposed)
+
+		assert.Equal(t, composed, decomposed.Normalize(norm.NFC))
+		assert.Equal(t, decomposed, composed.Normalize(norm.NFD))
+		assert.Equal(t, composed.Normalize(norm.NFD).Normalize(norm.NFC), composed)
+
+		assert.Equal(t, NewStringFromPtr(nil), NewStringFromPtr(nil).Normalize(norm.NFC))
+		assert.Equal(t, NewStringUndefined(), NewStringUndefined().Normalize(norm.NFC))
+	})
+
+	t.Run("Slugify", func(t *testing.T) {
+		tt := []struct {
+			input    string
+			expected string
+		}{
+			{input: "Årskurs 9 – Örebro", expected: "arskurs-9-orebro"},
+			{input: "Svenska för invandrare", expected: "svenska-for-invandrare"},
+			{input: "  Hälsa & idrott  ", expected: "halsa-idrott"},
+			{input: "Café_Ångström!", expected: "cafe-angstrom"},
+			{input: "already-a-slug", expected: "already-a-slug"},
+			{input: "!!!", expected: ""},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.input, func(t *testing.T) {
+				assert.Equal(t, NewString(tc.expected), NewString(tc.input).Slugify())
+			})
+		}
+
+		assert.Equal(t, NewStringFromPtr(nil), NewStringFromPtr(nil).Slugify())
+		assert.Equal(t, NewStringUndefined(), NewStringUndefined().Slugify())
+	})
+
+	t.Run("NormalizeWith", func(t *testing.T) {
+		spaces := regexp.MustCompile(`\s+`)
+
+		assert.Equal(t, NewString("Sal B 12"), NewString("Sal   B\t12").NormalizeWith(spaces, " "))
+		assert.Equal(t, NewString("MAT101"), NewString("MAT-101").NormalizeWith(regexp.MustCompile(`[^A-Z0-9]`), ""))
+		assert.True(t, NewStringFromPtr(nil).NormalizeWith(spaces, " ").IsNil())
+	})
+
+	t.Run("LooksNumeric", func(t *testing.T) {
+		assert.True(t, NewString("007").LooksNumeric())
+		assert.True(t, NewString("123").LooksNumeric())
+		assert.False(t, NewString("-1").LooksNumeric())
+		assert.False(t, NewString("1.5").LooksNumeric())
+		assert.False(t, NewString("").LooksNumeric())
+		assert.False(t, NewStringFromPtr(nil).LooksNumeric())
+
+		// Parsing drops the leading zeros, which is why numeric looking identifiers should be kept as String
+		i, err := IntFromString("007")
+		require.NoError(t, err)
+		assert.Equal(t, "7", i.String())
+		assert.Equal(t, "007", NewString(i.String()).PadLeft(3, '0').String())
+	})
+
+	t.Run("PadLeft", func(t *testing.T) {
+		assert.Equal(t, NewString("007"), NewString("7").PadLeft(3, '0'))
+		assert.Equal(t, NewString("  åäö"), NewString("åäö").PadLeft(5, ' '))
+		assert.Equal(t, NewString("12345"), NewString("12345").PadLeft(3, '0'))
+		assert.Equal(t, NewStringFromPtr(nil), NewStringFromPtr(nil).PadLeft(3, '0'))
+	})
+
+	t.Run("Set, SetNil and SetUndefined", func(t *testing.T) {
+		var s String
+
+		s.Set("hello")
+		assert.Equal(t, NewString("hello"), s)
+
+		s.SetNil()
+		assert.Equal(t, NewStringFromPtr(nil), s)
+		assert.True(t, s.IsDefined())
+		assert.True(t, s.IsNil())
+
+		s.SetUndefined()
+		assert.Equal(t, NewStringUndefined(), s)
+		assert.False(t, s.IsDefined())
+
+		// The other types match their constructors in the same way
+		var c Color
+		c.Set("#FFAA00")
+		assert.Equal(t, NewColor("#FFAA00"), c)
+
+		var n Nullable[uint8]
+		n.Set(7)
+		assert.Equal(t, NewNullable(uint8(7)), n)
+		n.SetNil()
+		assert.True(t, n.IsDefined())
+
+		var m Money
+		m.Set(1250, "sek")
+		assert.Equal(t, NewMoney(1250, "SEK"), m)
+		m.SetUndefined()
+		assert.False(t, m.IsDefined())
+	})
+
+	t.Run("Canonical", func(t *testing.T) {
+		decomposed := NewString(" A\u030asa \t O\u0308berg\n")
+		composed := NewString("åsa öberg")
+
+		assert.NotEqual(t, decomposed, composed)
+		assert.Equal(t, composed, decomposed.Canonical())
+		assert.Equal(t, composed.Canonical(), decomposed.Canonical())
+		assert.Equal(t, NewString(""), NewString("   ").Canonical())
+		assert.Equal(t, NewStringFromPtr(nil), NewStringFromPtr(nil).Canonical())
+	})
+}
+
+var (
+	testStatuses = NewStringEnum("active", "archived", "pending")
+	testLevels   = NewStringEnum("low", "high").CaseInsensitive()
+)
+
+type testStatus struct{}
+
+func (testStatus) StringEnum() *StringEnumSet { return testStatuses }
+
+type testLevel struct{}
+
+func (testLevel) StringEnum() *StringEnumSet { return testLevels }
+
+func TestStringEnum(t *testing.T) {
+	t.Run("StringEnumSet", func(t *testing.T) {
+		set := NewStringEnum("active", "archived", "pending")
+
+		s, err := set.FromString(" active ")
+		require.NoError(t, err)
+		assert.Equal(t, NewString("active"), s)
+
+		_, err = set.FromString("Active")
+		assert.EqualError(t, err, `invalid value "Active", must be one of: active, archived, pending`)
+
+		s, err = set.CaseInsensitive().FromString("Active")
+		require.NoError(t, err)
+		assert.Equal(t, NewString("active"), s)
+
+		s, err = set.FromString("")
+		require.NoError(t, err)
+		assert.True(t, s.IsNil())
+
+		assert.Equal(t, []string{"active", "archived", "pending"}, set.Allowed())
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		type student struct {
+			Status StringEnum[testStatus] `json:"status"`
+			Level  StringEnum[testLevel]  `json:"level"`
+		}
+
+		var s student
+		require.NoError(t, json.Unmarshal([]byte(`{"status": "pending", "level": "HIGH"}`), &s))
+		assert.Equal(t, "pending", s.Status.String())
+		assert.Equal(t, "high", s.Level.String())
+
+		data, err := json.Marshal(s)
+		require.NoError(t, err)
+		assert.Equal(t, `{"status":"pending","level":"high"}`, string(data))
+
+		s = student{}
+		require.NoError(t, json.Unmarshal([]byte(`{"status": null}`), &s))
+		assert.True(t, s.Status.IsDefined())
+		assert.True(t, s.Status.IsNil())
+		assert.False(t, s.Level.IsDefined())
+
+		err = json.Unmarshal([]byte(`{"status": "actve"}`), &s)
+		assert.ErrorContains(t, err, `invalid value "actve"`)
+	})
+
+	t.Run("Scan", func(t *testing.T) {
+		var status StringEnum[testStatus]
+		require.NoError(t, status.Scan("archived"))
+		assert.Equal(t, "archived", status.String())
+
+		require.NoError(t, status.Scan(nil))
+		assert.True(t, status.IsNil())
+
+		assert.Error(t, status.Scan("deleted"))
+		assert.False(t, status.IsDefined())
+
+		value, err := NewStringEnumUndefined[testStatus]().Value()
+		require.NoError(t, err)
+		assert.Nil(t, value)
+	})
+
+	t.Run("StringEnumFromString", func(t *testing.T) {
+		status, err := StringEnumFromString[testStatus]("active")
+		require.NoError(t, err)
+		assert.Equal(t, "active", status.String())
+		assert.Equal(t, NewString("active"), status.Val())
+
+		_, err = StringEnumFromString[testStatus]("unknown")
+		assert.Error(t, err)
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		var s StringEnum[testStatus]
+		require.NoError(t, s.Set("archived"))
+		assert.Equal(t, "archived", s.String())
+
+		assert.Error(t, s.Set("deleted"))
+		assert.Equal(t, "archived", s.String())
+
+		s.SetNil()
+		assert.True(t, s.IsDefined())
+		assert.True(t, s.IsNil())
+	})
+}
+
+func TestTime(t *testing.T) {
+	t.Run("Scan", func(t *testing.T) {
+		tt := []struct {
+			name     string
+			value    interface{}
+			expected string
+		}{
+			{name: "time", value: "14:30:00", expected: "14:30"},
+			{name: "time with fraction", value: "14:30:00.123456", expected: "14:30"},
+			{name: "timetz", value: "14:30:00+00", expected: "14:30"},
+			{name: "timetz with offset", value: "14:30:00+05:30", expected: "14:30"},
+			{name: "bytes", value: []byte("14:30:00+00"), expected: "14:30"},
+			{name: "duration", value: 14*time.Hour + 30*time.Minute, expected: "14:30"},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				var s Time
+				err := s.Scan(tc.value)
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expected, s.String())
+				assert.Equal(t, 0, s.Time().Year())
+				assert.Equal(t, time.UTC, s.Time().Location())
+			})
+		}
+
+		var s Time
+		assert.Error(t, s.Scan(25*time.Hour))
+		assert.Error(t, s.Scan(-time.Minute))
+
+		require.NoError(t, s.Scan(nil))
+		assert.True(t, s.IsNil())
+	})
+
+	t.Run("Scan time.Time keeps only the clock", func(t *testing.T) {
+		var s Time
+		require.NoError(t, s.Scan(time.Date(2024, 1, 2, 14, 30, 0, 0, time.UTC)))
+		assert.Equal(t, "14:30", s.String())
+		assert.Equal(t, time.Date(0, 1, 1, 14, 30, 0, 0, time.UTC), s.Time())
+
+		var seconds TimeSeconds
+		require.NoError(t, seconds.Scan(time.Date(2024, 1, 2, 14, 30, 15, 0, time.FixedZone("CET", 3600))))
+		assert.Equal(t, "14:30:15", seconds.String())
+	})
+
+	t.Run("SinceMidnight and NewTimeFromDuration", func(t *testing.T) {
+		midnight := MustTimeFromString("00:00")
+		assert.Equal(t, time.Duration(0), midnight.SinceMidnight())
+		assert.Equal(t, midnight, NewTimeFromDuration(0))
+
+		afternoon := MustTimeFromString("14:30")
+		assert.Equal(t, 14*time.Hour+30*time.Minute, afternoon.SinceMidnight())
+		assert.Equal(t, afternoon, NewTimeFromDuration(14*time.Hour+30*time.Minute))
+
+		// Out of range durations wrap around
+		assert.Equal(t, "01:00", NewTimeFromDuration(25*time.Hour).String())
+		assert.Equal(t, "23:30", NewTimeFromDuration(-30*time.Minute).String())
+		assert.Equal(t, "14:30", NewTimeFromDuration(14*time.Hour+30*time.Minute+59*time.Second).String())
+
+		assert.Equal(t, time.Duration(0), NewTimeFromPtr(nil).SinceMidnight())
+		assert.Equal(t, time.Duration(0), NewTimeUndefined().SinceMidnight())
+	})
+
+	t.Run("Before, After and Equal", func(t *testing.T) {
+		morning := MustTimeFromString("08:15")
+		afternoon := MustTimeFromString("14:30")
+
+		assert.True(t, morning.Before(afternoon))
+		assert.False(t, afternoon.Before(morning))
+		assert.True(t, afternoon.After(morning))
+		assert.False(t, morning.After(afternoon))
+		assert.True(t, morning.Equal(MustTimeFromString("08:15")))
+		assert.False(t, morning.Equal(afternoon))
+
+		// Only the hour and minute are compared
+		assert.True(t, morning.Equal(NewTime(time.Date(2024, 5, 1, 8, 15, 59, 0, time.Local))))
+
+		// Comparisons with nil are false, except for two nil values being equal
+		assert.False(t, morning.Before(NewTimeFromPtr(nil)))
+		assert.False(t, NewTimeFromPtr(nil).After(morning))
+		assert.False(t, morning.Equal(NewTimeUndefined()))
+		assert.True(t, NewTimeFromPtr(nil).Equal(NewTimeUndefined()))
+	})
+}
+
+func TestTimeSeconds(t *testing.T) {
+	t.Run("TimeSecondsFromString", func(t *testing.T) {
+		ts, err := TimeSecondsFromString("09:30:45")
+		require.NoError(t, err)
+		assert.Equal(t, "09:30:45", ts.String())
+
+		ts, err = TimeSecondsFromString("")
+		require.NoError(t, err)
+		assert.True(t, ts.IsNil())
+
+		_, err = TimeSecondsFromString("09:30")
+		assert.Error(t, err)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		var ts TimeSeconds
+		require.NoError(t, json.Unmarshal([]byte(`"23:59:01"`), &ts))
+		assert.Equal(t, "23:59:01", ts.String())
+
+		data, err := json.Marshal(ts)
+		require.NoError(t, err)
+		assert.Equal(t, `"23:59:01"`, string(data))
+
+		data, err = json.Marshal(NewTimeSecondsFromPtr(nil))
+		require.NoError(t, err)
+		assert.Equal(t, "null", string(data))
+	})
+
+	t.Run("seconds are kept from the database", func(t *testing.T) {
+		var ts TimeSeconds
+		require.NoError(t, ts.Scan("09:30:45"))
+
+		data, err := json.Marshal(ts)
+		require.NoError(t, err)
+		assert.Equal(t, `"09:30:45"`, string(data))
+
+		var minutes Time
+		require.NoError(t, minutes.Scan("09:30:45"))
+		assert.Equal(t, "09:30", minutes.String())
+	})
+
+	t.Run("NewTimeSeconds", func(t *testing.T) {
+		ts := NewTimeSeconds(time.Date(2024, 1, 2, 9, 30, 45, 123, time.UTC))
+		assert.Equal(t, time.Date(0, 1, 1, 9, 30, 45, 0, time.UTC), ts.TimeSeconds())
+	})
+
+	t.Run("DurationFromClockString", func(t *testing.T) {
+		tt := []struct {
+			input    string
+			expected time.Duration
+			wantErr  bool
+		}{
+			{input: "00:45:30", expected: 45*time.Minute + 30*time.Second},
+			{input: "01:30:45", expected: time.Hour + 30*time.Minute + 45*time.Second},
+			{input: "30:00:00", expected: 30 * time.Hour},
+			{input: "120:05:00", expected: 120*time.Hour + 5*time.Minute},
+			{input: "2562047:47:16", expected: 2562047*time.Hour + 47*time.Minute + 16*time.Second},
+			{input: "2562047:47:17", wantErr: true},
+			{input: "3000000:00:00", wantErr: true},
+			{input: "99999999999999999999:00:00", wantErr: true},
+			{input: " 1:00:00 ", expected: time.Hour},
+			{input: "01:30", wantErr: true},
+			{input: "01:60:00", wantErr: true},
+			{input: "01:00:60", wantErr: true},
+			{input: "01:5:00", wantErr: true},
+			{input: "-01:00:00", wantErr: true},
+			{input: "aa:bb:cc", wantErr: true},
+			{input: "", wantErr: true},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.input, func(t *testing.T) {
+				d, err := DurationFromClockString(tc.input)
+				if tc.wantErr {
+					assert.Error(t, err)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, d)
+			})
+		}
+	})
+}
+
+func TestTimestamp(t *testing.T) {
+	t.Run("StartOfDay", func(t *testing.T) {
+		currentTime := time.Now().UTC()
+
+		tz := currentTime.Location()
+
+		timestamp := NewTimestamp(currentTime).StartOfDay(tz).Timestamp()
+
+		assert.Equal(t, currentTime.Year(), timestamp.Year())
+		assert.Equal(t, currentTime.Month(), timestamp.Month())
+		assert.Equal(t, currentTime.Day(), timestamp.Day())
+		assert.Equal(t, 0, timestamp.Hour())
+		assert.Equal(t, 0, timestamp.Minute())
+		assert.Equal(t, 0, timestamp.Second())
+		assert.Equal(t, 0, timestamp.Nanosecond())
+	})
+
+	t.Run("EndOfDay", func(t *testing.T) {
+		currentTime := time.Now().UTC()
+
+		tz := currentTime.Location()
+
+		timestamp := NewTimestamp(currentTime).EndOfDay(tz).Timestamp()
+
+		assert.Equal(t, currentTime.Year(), timestamp.Year())
+		assert.Equal(t, currentTime.Month(), timestamp.Month())
+		assert.Equal(t, currentTime.Day(), timestamp.Day())
+		assert.Equal(t, 23, timestamp.Hour())
+		assert.Equal(t, 59, timestamp.Minute())
+		assert.Equal(t, 59, timestamp.Second())
+		assert.Equal(t, 999999999, timestamp.Nanosecond())
+	})
+
+	t.Run("TimestampFromString", func(t *testing.T) {
+		tt := []struct {
+			input, expected string
+			err             error
+		}{
+			{input: "2023-12-25T15:04:05Z", expected: "2023-12-25T15:04:05Z"},
+			{input: "2023-12-25 15:04:05Z", expected: "2023-12-25T15:04:05Z"},
+			{input: "2023-12-25T15:04:05", expected: "2023-12-25T15:04:05Z"},
+			{input: "2023-12-25 15:04:05", expected: "2023-12-25T15:04:05Z"},
+			{input: "2023-12-25T15:04", expected: "2023-12-25T15:04:00Z"},
+			{input: "2023-12-25 15:04", expected: "2023-12-25T15:04:00Z"},
+			{input: "2023-12-25", expected: "2023-12-25T00:00:00Z"},
+			{input: "2023-12-xx", err: errors.New("parsing time \"2023-12-xx\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"xx\" as \"02\"")},
+			{input: "1/20/25 11:23", expected: "2025-01-20T11:23:00Z"},
+			{input: "1/20/25 11:23:02", expected: "2025-01-20T11:23:02Z"},
+			{input: "01/20/2025 11:23:02", expected: "2025-01-20T11:23:02Z"},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.input, func(t *testing.T) {
+				timestamp, err := TimestampFromString(tc.input)
+
+				if tc.err != nil {
+					require.Error(t, err)
+					assert.Equal(t, tc.err.Error(), err.Error())
+					return
+				}
+
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expected, timestamp.String())
+			})
+		}
+	})
+
+	t.Run("Chaining", func(t *testing.T) {
+		stockholm, err := time.LoadLocation("Europe/Stockholm")
+		require.NoError(t, err)
+
+		// Sunday 23:30 UTC is Monday 00:30 in Stockholm (CET, UTC+1)
+		timestamp, err := TimestampFromString("2024-01-07T23:30:00Z")
+		require.NoError(t, err)
+
+		assert.Equal(t, "2024-01-01T00:00:00Z", timestamp.StartOfWeek().String())
+		assert.Equal(t, "2024-01-08T00:00:00+01:00", timestamp.In(stockholm).StartOfWeek().String())
+		assert.Equal(t, "2024-01-08T08:00:00+01:00", timestamp.In(stockholm).StartOfWeek().Add(8*time.Hour).String())
+		assert.Equal(t, "2024-02-08T08:00:00+01:00", timestamp.In(stockholm).StartOfWeek().Add(8*time.Hour).AddDate(0, 1, 0).String())
+		assert.True(t, timestamp.In(stockholm).StartOfWeek().Add(8*time.Hour).In(time.UTC).Equal(
+			NewTimestamp(time.Date(2024, 1, 8, 7, 0, 0, 0, time.UTC)),
+		))
+
+		null := NewTimestampFromPtr(nil).In(stockholm).StartOfWeek().Add(time.Hour).AddDate(0, 0, 1)
+		assert.True(t, null.IsDefined())
+		assert.True(t, null.IsNil())
+
+		undefined := NewTimestampUndefined().In(stockholm).StartOfWeek().Add(time.Hour).AddDate(0, 0, 1)
+		assert.False(t, undefined.IsDefined())
+	})
+
+	t.Run("Add, Sub and Truncate", func(t *testing.T) {
+		stockholm, err := time.LoadLocation("Europe/Stockholm")
+		require.NoError(t, err)
+
+		ts := NewTimestamp(time.Date(2024, 6, 1, 12, 34, 56, 0, stockholm))
+		require.Equal(t, time.UTC, ts.Timestamp().Location())
+
+		later := ts.Add(90 * time.Minute)
+		assert.Equal(t, time.UTC, later.Timestamp().Location())
+		assert.Equal(t, time.Date(2024, 6, 1, 12, 4, 56, 0, time.UTC), later.Timestamp())
+		assert.Equal(t, 90*time.Minute, later.Sub(ts))
+		assert.Equal(t, -90*time.Minute, ts.Sub(later))
+
+		truncated := ts.Truncate(time.Hour)
+		assert.Equal(t, time.UTC, truncated.Timestamp().Location())
+		assert.Equal(t, time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC), truncated.Timestamp())
+	})
+
+	t.Run("Add, Sub and Truncate on nil", func(t *testing.T) {
+		null := NewTimestampFromPtr(nil)
+		ts := NewTimestamp(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+
+		assert.True(t, null.Add(time.Hour).IsNil())
+		assert.True(t, null.Truncate(time.Hour).IsNil())
+		assert.True(t, null.Truncate(time.Hour).IsDefined())
+		assert.False(t, NewTimestampUndefined().Truncate(time.Hour).IsDefined())
+		assert.Equal(t, time.Duration(0), null.Sub(ts))
+		assert.Equal(t, time.Duration(0), ts.Sub(null))
+	})
+
+	t.Run("NotAfterNow", func(t *testing.T) {
+		stockholm, err := time.LoadLocation("Europe/Stockholm")
+		require.NoError(t, err)
+
+		now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+		SetNow(func() time.Time { return now })
+		t.Cleanup(func() { SetNow(nil) })
+
+		assert.NoError(t, NewTimestamp(now.Add(-time.Second)).NotAfterNow(stockholm))
+		assert.NoError(t, NewTimestamp(now).NotAfterNow(stockholm))
+		assert.EqualError(t, NewTimestamp(now.Add(time.Second)).NotAfterNow(stockholm), "timestamp 2024-06-01T14:00:01+02:00 is after now (2024-06-01T14:00:00+02:00)")
+		assert.NoError(t, NewTimestampFromPtr(nil).NotAfterNow(stockholm))
+	})
+
+	t.Run("StartOfDay and EndOfDay in location", func(t *testing.T) {
+		stockholm, err := time.LoadLocation("Europe/Stockholm")
+		require.NoError(t, err)
+
+		tt := []struct {
+			name          string
+			input         time.Time
+			expectedStart time.Time
+			expectedEnd   time.Time
+		}{
+			{
+				// 23:30 UTC is already the next day in Stockholm (UTC+1)
+				name:          "near midnight in winter",
+				input:         time.Date(2024, 1, 15, 23, 30, 0, 0, time.UTC),
+				expectedStart: time.Date(2024, 1, 15, 23, 0, 0, 0, time.UTC),
+				expectedEnd:   time.Date(2024, 1, 16, 22, 59, 59, 999999999, time.UTC),
+			},
+			{
+				// The clocks are moved forward at 02:00 on 2024-03-31, so the day is 23 hours long
+				name:          "start of summer time",
+				input:         time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC),
+				expectedStart: time.Date(2024, 3, 30, 23, 0, 0, 0, time.UTC),
+				expectedEnd:   time.Date(2024, 3, 31, 21, 59, 59, 999999999, time.UTC),
+			},
+			{
+				// The clocks are moved back at 03:00 on 2024-10-27, so the day is 25 hours long
+				name:          "end of summer time",
+				input:         time.Date(2024, 10, 26, 22, 30, 0, 0, time.UTC),
+				expectedStart: time.Date(2024, 10, 26, 22, 0, 0, 0, time.UTC),
+				expectedEnd:   time.Date(2024, 10, 27, 22, 59, 59, 999999999, time.UTC),
+			},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				ts := NewTimestamp(tc.input)
+
+				assert.Equal(t, tc.expectedStart, ts.StartOfDay(stockholm).Timestamp())
+				assert.Equal(t, tc.expectedEnd, ts.EndOfDay(stockholm).Timestamp())
+			})
+		}
+	})
+
+	t.Run("TruncateToMinute", func(t *testing.T) {
+		ts := NewTimestamp(time.Date(2024, 6, 1, 12, 34, 56, 0, time.UTC))
+
+		assert.Equal(t, time.Date(2024, 6, 1, 12, 34, 0, 0, time.UTC), ts.TruncateToMinute().Timestamp())
+		assert.True(t, NewTimestampFromPtr(nil).TruncateToMinute().IsNil())
+	})
+
+	t.Run("TimestampMinute JSON", func(t *testing.T) {
+		ts := NewTimestamp(time.Date(2024, 6, 1, 12, 34, 56, 0, time.UTC))
+
+		data, err := json.Marshal(NewTimestampMinuteMode(ts))
+		require.NoError(t, err)
+		assert.Equal(t, `"2024-06-01T12:34Z"`, string(data))
+
+		data, err = json.Marshal(ts)
+		require.NoError(t, err)
+		assert.Equal(t, `"2024-06-01T12:34:56Z"`, string(data))
+
+		data, err = json.Marshal(NewTimestampMinuteMode(NewTimestampFromPtr(nil)))
+		require.NoError(t, err)
+		assert.Equal(t, "null", string(data))
+
+		for _, input := range []string{`"2024-06-01T14:34+02:00"`, `"2024-06-01T12:34:56Z"`} {
+			var minute TimestampMinute
+			require.NoError(t, json.Unmarshal([]byte(input), &minute))
+			assert.Equal(t, time.Date(2024, 6, 1, 12, 34, 0, 0, time.UTC), minute.Timestamp.Timestamp())
+		}
+	})
+
+	t.Run("TimestampFromStringFlexible", func(t *testing.T) {
+		tt := []struct {
+			input    string
+			expected time.Time
+		}{
+			{"2024-01-02 14:30:00 CET", time.Date(2024, 1, 2, 13, 30, 0, 0, time.UTC)},
+			{"2024-07-02 14:30:00 CEST", time.Date(2024, 7, 2, 12, 30, 0, 0, time.UTC)},
+			{"2024-07-02T14:30 cest", time.Date(2024, 7, 2, 12, 30, 0, 0, time.UTC)},
+			{"2024-01-02 14:30:00 GMT", time.Date(2024, 1, 2, 14, 30, 0, 0, time.UTC)},
+			{"2024-01-02 14:30:00", time.Date(2024, 1, 2, 14, 30, 0, 0, time.UTC)},
+			{"2024-01-02T14:30:00+01:00", time.Date(2024, 1, 2, 13, 30, 0, 0, time.UTC)},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.input, func(t *testing.T) {
+				ts, err := TimestampFromStringFlexible(tc.input)
+				require.NoError(t, err)
+				assert.True(t, tc.expected.Equal(ts.Timestamp()), ts.String())
+			})
+		}
+
+		_, err := TimestampFromStringFlexible("2024-01-02 14:30:00 XYZ")
+		assert.Error(t, err)
+
+		_, err = TimestampFromStringFlexible(" CET")
+		assert.Error(t, err)
+	})
+
+	t.Run("RegisterTimestampZoneAbbreviation", func(t *testing.T) {
+		t.Cleanup(func() {
+			timestampZoneAbbreviationsMu.Lock()
+			defer timestampZoneAbbreviationsMu.Unlock()
+
+			delete(timestampZoneAbbreviations, "EET")
+		})
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+
+			for i := 0; i < 100; i++ {
+				_, _ = TimestampFromStringFlexible("2024-01-02 14:30:00 EET")
+			}
+		}()
+
+		RegisterTimestampZoneAbbreviation("eet", 2*60*60)
+		<-done
+
+		ts, err := TimestampFromStringFlexible("2024-01-02 14:30:00 EET")
+		require.NoError(t, err)
+		assert.True(t, time.Date(2024, 1, 2, 12, 30, 0, 0, time.UTC).Equal(ts.Timestamp()), ts.String())
+
+		assert.Panics(t, func() { RegisterTimestampZoneAbbreviation("CET", 2*60*60) })
+	})
+
+	t.Run("MarshalJSON allocates once", func(t *testing.T) {
+		ts := NewTimestamp(time.Date(2024, 6, 1, 12, 34, 56, 0, time.UTC))
+
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = ts.MarshalJSON()
+		})
+		assert.Equal(t, float64(1), allocs)
+	})
+
+	t.Run("Unix", func(t *testing.T) {
+		ts := NewTimestampFromUnix(1717245296)
+		assert.Equal(t, time.Date(2024, 6, 1, 12, 34, 56, 0, time.UTC), ts.Timestamp())
+		assert.Equal(t, int64(1717245296), ts.Unix())
+		assert.Equal(t, int64(1717245296000), ts.UnixMilli())
+
+		ms := NewTimestampFromUnixMilli(1717245296789)
+		assert.Equal(t, time.Date(2024, 6, 1, 12, 34, 56, 789000000, time.UTC), ms.Timestamp())
+		assert.Equal(t, int64(1717245296789), ms.UnixMilli())
+		assert.Equal(t, int64(1717245296), ms.Unix())
+
+		assert.Equal(t, int64(0), NewTimestampFromPtr(nil).Unix())
+		assert.Equal(t, int64(0), NewTimestampUndefined().UnixMilli())
+	})
+
+	t.Run("UnixTimestamp JSON", func(t *testing.T) {
+		var payload struct {
+			At      UnixTimestamp
+			Missing UnixTimestamp
+		}
+
+		require.NoError(t, json.Unmarshal([]byte(`{"At":1717245296}`), &payload))
+		assert.Equal(t, time.Date(2024, 6, 1, 12, 34, 56, 0, time.UTC), payload.At.Timestamp.Timestamp())
+		assert.False(t, payload.Missing.IsDefined())
+
+		data, err := json.Marshal(payload)
+		require.NoError(t, err)
+		assert.Equal(t, `{"At":1717245296,"Missing":null}`, string(data))
+
+		var at UnixTimestamp
+		require.NoError(t, json.Unmarshal([]byte(`null`), &at))
+		assert.True(t, at.IsDefined())
+		assert.True(t, at.IsNil())
+
+		assert.Error(t, json.Unmarshal([]byte(`"2024-06-01T12:34:56Z"`), &at))
+	})
+
+	t.Run("BucketTimestamps", func(t *testing.T) {
+		at := func(hour, minute int) Timestamp {
+			return NewTimestamp(time.Date(2024, 6, 1, hour, minute, 0, 0, time.UTC))
+		}
+
+		ts := []Timestamp{
+			at(9, 0),
+			at(9, 15),
+			at(9, 59),
+			at(10, 0),
+			at(12, 30),
+			NewTimestampFromPtr(nil),
+			NewTimestampUndefined(),
+		}
+
+		assert.Equal(t, map[Timestamp]int{
+			at(9, 0):  3,
+			at(10, 0): 1,
+			at(12, 0): 1,
+		}, BucketTimestamps(ts, time.Hour, time.UTC))
+
+		assert.Empty(t, BucketTimestamps(nil, time.Hour, time.UTC))
+	})
+
+	t.Run("BucketTimestamps daily in location", func(t *testing.T) {
+		stockholm, err := time.LoadLocation("Europe/Stockholm")
+		require.NoError(t, err)
+
+		ts := []Timestamp{
+			NewTimestamp(time.Date(2024, 6, 1, 21, 30, 0, 0, time.UTC)), // 23:30 in Stockholm
+			NewTimestamp(time.Date(2024, 6, 1, 22, 30, 0, 0, time.UTC)), // 00:30 the next day in Stockholm
+		}
+
+		assert.Equal(t, map[Timestamp]int{
+			NewTimestamp(time.Date(2024, 5, 31, 22, 0, 0, 0, time.UTC)): 1,
+			NewTimestamp(time.Date(2024, 6, 1, 22, 0, 0, 0, time.UTC)):  1,
+		}, BucketTimestamps(ts, 24*time.Hour, stockholm))
+	})
+
+	t.Run("DateIn", func(t *testing.T) {
+		stockholm, err := time.LoadLocation("Europe/Stockholm")
+		require.NoError(t, err)
+
+		// 23:30 in Stockholm on the 1st of February
+		ts := NewTimestamp(time.Date(2024, 2, 1, 22, 30, 0, 0, time.UTC))
+		assert.Equal(t, "2024-02-01", ts.Date().String())
+		assert.Equal(t, "2024-02-01", ts.DateIn(stockholm).String())
+
+		// 00:30 in Stockholm on the 2nd of February
+		ts = NewTimestamp(time.Date(2024, 2, 1, 23, 30, 0, 0, time.UTC))
+		assert.Equal(t, "2024-02-01", ts.Date().String())
+		assert.Equal(t, "2024-02-02", ts.DateIn(stockholm).String())
+
+		assert.True(t, NewTimestampFromPtr(nil).DateIn(stockholm).IsNil())
+	})
+
+	t.Run("NextWeekdayTime", func(t *testing.T) {
+		stockholm, err := time.LoadLocation("Europe/Stockholm")
+		require.NoError(t, err)
+
+		eight, err := TimeFromString("08:00")
+		require.NoError(t, err)
+
+		tt := []struct {
+			name     string
+			from     time.Time
+			expected time.Time
 		}{
 			{
-				content:  "<p>Paragraf</p><h1>Stor rubrik</h1><h2>Medium rubrik</h2><h3>Liten rubrik</h3><ul><li><p>punkt</p></li><li><p>lista</p></li></ul><ol><li><p>numrerad </p></li><li><p>lista</p><pre><code>Kodblock</code></pre></li></ol><p><strong>FET </strong><em>KURSIV </em> <u>UNDERSTRYKNING</u> <s>GENOMSTRUKEN</s> <code>KODD</code> <a target=\\\"_blank\\\" rel=\\\"noopener noreferrer nofollow\\\" href=\\\"https://google.com\\\">länk till google</a></p><p></p><img src=\\\"https://fileserver.develop.meitner.se/v1/file/efe378e5-e263-438c-841c-07ab20c60bc0.png\\\">",
-				expected: "Paragraf\n\nStor rubrik\n\nMedium rubrik\n\nLiten rubrik\n\npunkt\n\nlista\n\nnumrerad \n\nlista\n\nKodblock\n\nFET KURSIV  UNDERSTRYKNING GENOMSTRUKEN KODD länk till google\n\n",
+				name:     "monday before the time",
+				from:     time.Date(2024, 6, 3, 7, 30, 0, 0, stockholm),
+				expected: time.Date(2024, 6, 3, 8, 0, 0, 0, stockholm),
 			},
 			{
-				content:  "<ul><li><p>punkt</p></li><li><p>lista</p></li></ul>",
-				expected: "punkt\n\nlista",
+				name:     "monday at the time",
+				from:     time.Date(2024, 6, 3, 8, 0, 0, 0, stockholm),
+				expected: time.Date(2024, 6, 10, 8, 0, 0, 0, stockholm),
 			},
 			{
-				content:  "<p>hej</p><p>på dig</p><p></p>",
-				expected: "hej\n\npå dig",
+				name:     "monday after the time",
+				from:     time.Date(2024, 6, 3, 9, 0, 0, 0, stockholm),
+				expected: time.Date(2024, 6, 10, 8, 0, 0, 0, stockholm),
+			},
+			{
+				name:     "wednesday",
+				from:     time.Date(2024, 6, 5, 12, 0, 0, 0, stockholm),
+				expected: time.Date(2024, 6, 10, 8, 0, 0, 0, stockholm),
+			},
+			{
+				name:     "sunday evening in utc is monday in stockholm",
+				from:     time.Date(2024, 6, 2, 23, 30, 0, 0, time.UTC),
+				expected: time.Date(2024, 6, 3, 8, 0, 0, 0, stockholm),
 			},
 		}
 
 		for _, tc := range tt {
-			t.Run(tc.content, func(t *testing.T) {
-				input := fmt.Sprintf(`{"content":"%s"}`, tc.content)
+			t.Run(tc.name, func(t *testing.T) {
+				next := NewTimestamp(tc.from).NextWeekdayTime(time.Monday, eight, stockholm)
+				assert.True(t, tc.expected.Equal(next.Timestamp()), next.String())
+			})
+		}
 
-				var output RichText
-				err := json.Unmarshal([]byte(input), &output)
-				require.NoError(t, err)
+		assert.True(t, NewTimestampFromPtr(nil).NextWeekdayTime(time.Monday, eight, stockholm).IsNil())
+		assert.True(t, NewTimestamp(time.Now()).NextWeekdayTime(time.Monday, NewTimeFromPtr(nil), stockholm).IsNil())
+	})
 
-				text, err := output.Text()
+	t.Run("MinTimestamp and MaxTimestamp", func(t *testing.T) {
+		early := NewTimestamp(time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC))
+		late := NewTimestamp(time.Date(2024, 5, 1, 17, 0, 0, 0, time.UTC))
+
+		values := []Timestamp{late, NewTimestampFromPtr(nil), early}
+		assert.Equal(t, early, MinTimestamp(values))
+		assert.Equal(t, late, MaxTimestamp(values))
+		assert.Equal(t, late, MinTimestamp([]Timestamp{late}))
+		assert.True(t, MinTimestamp([]Timestamp{NewTimestampUndefined(), NewTimestampFromPtr(nil)}).IsNil())
+		assert.True(t, MaxTimestamp(nil).IsNil())
+
+		assert.Equal(t, late, NewTimestamp(time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)).Clamp(early, late))
+		assert.Equal(t, early, NewTimestamp(time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)).Clamp(early, NewTimestampFromPtr(nil)))
+	})
+
+	t.Run("TimestampFromStringWithZoneInfo", func(t *testing.T) {
+		tt := []struct {
+			input   string
+			hasZone bool
+		}{
+			{"2024-01-02T15:04:05+02:00", true},
+			{"2024-01-02 15:04:05Z", true},
+			{"2024-01-02T15:04:05", false},
+			{"2024-01-02 15:04", false},
+			{"2024-01-02", false},
+			{"1/2/2006 15:04", false},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.input, func(t *testing.T) {
+				ts, hasZone, err := TimestampFromStringWithZoneInfo(tc.input)
 				require.NoError(t, err)
+				assert.Equal(t, tc.hasZone, hasZone)
 
-				assert.Equal(t, tc.expected, text)
+				// The zone info is not part of the value, so it is equal to the constructed Timestamp
+				parsed, err := TimestampFromString(tc.input)
+				require.NoError(t, err)
+				assert.True(t, ts == parsed)
 			})
 		}
+
+		// A zone-less Timestamp is still equal to the constructed one, e.g. as a map key
+		ts, _, err := TimestampFromStringWithZoneInfo("2024-01-02 15:04:05")
+		require.NoError(t, err)
+		assert.True(t, ts == NewTimestamp(time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)))
+
+		ts, hasZone, err := TimestampFromStringWithZoneInfo("")
+		require.NoError(t, err)
+		assert.False(t, hasZone)
+		assert.True(t, ts.IsNil())
+
+		_, _, err = TimestampFromStringWithZoneInfo("yesterday")
+		assert.Error(t, err)
+	})
+
+	t.Run("NewTimestamp keeps the instant", func(t *testing.T) {
+		input := time.Date(2023, 6, 1, 23, 30, 0, 0, time.FixedZone("", 2*60*60))
+
+		ts := NewTimestamp(input)
+		assert.Equal(t, input.Unix(), ts.Timestamp().Unix())
+		assert.Equal(t, "2023-06-01T21:30:00Z", ts.String())
+		assert.Equal(t, time.UTC, ts.Timestamp().Location())
+
+		// Sub-second precision is truncated, but years beyond 9999 are kept
+		assert.Equal(t, input.Unix(), NewTimestamp(input.Add(999*time.Millisecond)).Timestamp().Unix())
+		assert.Equal(t, 10000, NewTimestamp(time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC)).Timestamp().Year())
 	})
 }
 
-func TestTimestamp(t *testing.T) {
-	t.Run("StartOfDay", func(t *testing.T) {
-		currentTime := time.Now().UTC()
+// recordingDriver is a database driver which records the arguments bound to the statements,
+// both the Go type received before conversion and the driver value after conversion.
+type recordingDriver struct {
+	boundTypes  []string
+	boundValues []driver.Value
+}
 
-		tz := currentTime.Location()
+func (d *recordingDriver) Open(string) (driver.Conn, error) { return &recordingConn{driver: d}, nil }
 
-		timestamp := NewTimestamp(currentTime).StartOfDay(tz).Timestamp()
+// Connect and Driver implement driver.Connector, so the driver can be used with sql.OpenDB
+// without registering it globally, which would panic when the tests run more than once.
+func (d *recordingDriver) Connect(context.Context) (driver.Conn, error) { return d.Open("") }
+func (d *recordingDriver) Driver() driver.Driver                        { return d }
 
-		assert.Equal(t, currentTime.Year(), timestamp.Year())
-		assert.Equal(t, currentTime.Month(), timestamp.Month())
-		assert.Equal(t, currentTime.Day(), timestamp.Day())
-		assert.Equal(t, 0, timestamp.Hour())
-		assert.Equal(t, 0, timestamp.Minute())
-		assert.Equal(t, 0, timestamp.Second())
-		assert.Equal(t, 0, timestamp.Nanosecond())
+type recordingConn struct {
+	driver *recordingDriver
+}
+
+func (c *recordingConn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (c *recordingConn) Close() error                        { return nil }
+func (c *recordingConn) Begin() (driver.Tx, error)           { return nil, driver.ErrSkip }
+
+func (c *recordingConn) CheckNamedValue(nv *driver.NamedValue) error {
+	c.driver.boundTypes = append(c.driver.boundTypes, fmt.Sprintf("%T", nv.Value))
+
+	// Let database/sql convert the value with the default converter.
+	return driver.ErrSkip
+}
+
+func (c *recordingConn) ExecContext(_ context.Context, _ string, args []driver.NamedValue) (driver.Result, error) {
+	for _, arg := range args {
+		c.driver.boundValues = append(c.driver.boundValues, arg.Value)
+	}
+
+	return driver.RowsAffected(1), nil
+}
+
+func TestTimestampLocal(t *testing.T) {
+	stockholm, err := time.LoadLocation("Europe/Stockholm")
+	require.NoError(t, err)
+
+	SetTimestampLocalLocation(stockholm)
+	t.Cleanup(func() { SetTimestampLocalLocation(nil) })
+
+	// The mocks behave like Postgres and the drivers, which return both column types with the location UTC.
+	// A timestamptz column stores the instant, a timestamp column stores the wall clock and drops the offset.
+	timestamptzColumn := func(v driver.Value) any { return v.(time.Time).UTC() }
+	timestampColumn := func(v driver.Value) any { return inLocation(v.(time.Time), time.UTC) }
+
+	instant := time.Date(2024, 6, 1, 8, 30, 0, 0, time.UTC) // 10:30 in Stockholm
+
+	t.Run("Timestamp in timestamptz column", func(t *testing.T) {
+		value, err := NewTimestamp(instant).Value()
+		require.NoError(t, err)
+
+		var ts Timestamp
+		require.NoError(t, ts.Scan(timestamptzColumn(value)))
+		assert.True(t, instant.Equal(ts.Timestamp()))
 	})
 
-	t.Run("EndOfDay", func(t *testing.T) {
-		currentTime := time.Now().UTC()
+	t.Run("TimestampLocal in timestamp column", func(t *testing.T) {
+		value, err := NewTimestampLocal(NewTimestamp(instant)).Value()
+		require.NoError(t, err)
 
-		tz := currentTime.Location()
+		stored := timestampColumn(value)
+		assert.Equal(t, time.Date(2024, 6, 1, 10, 30, 0, 0, time.UTC), stored)
 
-		timestamp := NewTimestamp(currentTime).EndOfDay(tz).Timestamp()
+		var ts TimestampLocal
+		require.NoError(t, ts.Scan(stored))
+		assert.True(t, instant.Equal(ts.Timestamp.Timestamp()))
+		assert.Equal(t, "2024-06-01T08:30:00Z", ts.String())
 
-		assert.Equal(t, currentTime.Year(), timestamp.Year())
-		assert.Equal(t, currentTime.Month(), timestamp.Month())
-		assert.Equal(t, currentTime.Day(), timestamp.Day())
-		assert.Equal(t, 23, timestamp.Hour())
-		assert.Equal(t, 59, timestamp.Minute())
-		assert.Equal(t, 59, timestamp.Second())
-		assert.Equal(t, 0, timestamp.Nanosecond())
+		// Winter time has another offset
+		require.NoError(t, ts.Scan([]byte("2024-01-15 10:30:00")))
+		assert.Equal(t, "2024-01-15T09:30:00Z", ts.String())
 	})
 
-	t.Run("TimestampFromString", func(t *testing.T) {
+	t.Run("pgtype", func(t *testing.T) {
+		value, err := NewTimestampLocal(NewTimestamp(instant)).TimestampValue()
+		require.NoError(t, err)
+		assert.True(t, value.Valid)
+		assert.Equal(t, time.Date(2024, 6, 1, 10, 30, 0, 0, time.UTC), value.Time)
+
+		var ts TimestampLocal
+		require.NoError(t, ts.ScanTimestamp(value))
+		assert.True(t, instant.Equal(ts.Timestamp.Timestamp()))
+
+		require.NoError(t, ts.ScanTimestamp(pgtype.Timestamp{}))
+		assert.True(t, ts.IsDefined())
+		assert.True(t, ts.IsNil())
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		value, err := NewTimestampLocal(NewTimestampFromPtr(nil)).Value()
+		require.NoError(t, err)
+		assert.Nil(t, value)
+
+		var ts TimestampLocal
+		require.NoError(t, ts.Scan(nil))
+		assert.True(t, ts.IsDefined())
+		assert.True(t, ts.IsNil())
+
+		assert.Error(t, ts.Scan(42))
+	})
+}
+
+func TestTypedNull(t *testing.T) {
+	recorder := &recordingDriver{}
+
+	db := sql.OpenDB(recorder)
+	defer db.Close()
+
+	for _, typeAsString := range []string{"types.Bool", "Date", "Float64", "Int", "Int16", "Int64", "JSON", "RichText", "String", "Time", "Timestamp", "UUID"} {
+		t.Run(typeAsString, func(t *testing.T) {
+			recorder.boundTypes = nil
+			recorder.boundValues = nil
+
+			null, err := TypedNull(typeAsString)
+			require.NoError(t, err)
+
+			_, err = db.Exec("INSERT INTO t (c) VALUES ($1)", null)
+			require.NoError(t, err)
+
+			assert.Equal(t, []string{"types." + strings.TrimPrefix(typeAsString, "types.")}, recorder.boundTypes)
+			assert.Equal(t, []driver.Value{nil}, recorder.boundValues)
+		})
+	}
+
+	t.Run("invalid type", func(t *testing.T) {
+		_, err := TypedNull("types.Unknown")
+		require.Error(t, err)
+		assert.Equal(t, "invalid type: types.Unknown", err.Error())
+	})
+}
+
+func TestUUID(t *testing.T) {
+	t.Run("DuplicateUUIDs", func(t *testing.T) {
+		a := NewUUID(uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"))
+		b := NewUUID(uuid.MustParse("223e4567-e89b-12d3-a456-426614174000"))
+		c := NewUUID(uuid.MustParse("323e4567-e89b-12d3-a456-426614174000"))
+
 		tt := []struct {
-			input, expected string
-			err             error
+			name     string
+			uuids    []UUID
+			expected []UUID
 		}{
-			{input: "2023-12-25T15:04:05Z", expected: "2023-12-25T15:04:05Z"},
-			{input: "2023-12-25 15:04:05Z", expected: "2023-12-25T15:04:05Z"},
-			{input: "2023-12-25T15:04:05", expected: "2023-12-25T15:04:05Z"},
-			{input: "2023-12-25 15:04:05", expected: "2023-12-25T15:04:05Z"},
-			{input: "2023-12-25T15:04", expected: "2023-12-25T15:04:00Z"},
-			{input: "2023-12-25 15:04", expected: "2023-12-25T15:04:00Z"},
-			{input: "2023-12-25", expected: "2023-12-25T00:00:00Z"},
-			{input: "2023-12-xx", err: errors.New("parsing time \"2023-12-xx\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"xx\" as \"02\"")},
-			{input: "1/20/25 11:23", expected: "2025-01-20T11:23:00Z"},
-			{input: "1/20/25 11:23:02", expected: "2025-01-20T11:23:02Z"},
-			{input: "01/20/2025 11:23:02", expected: "2025-01-20T11:23:02Z"},
+			{name: "duplicates", uuids: []UUID{a, b, c, b, a, b}, expected: []UUID{b, a}},
+			{name: "all unique", uuids: []UUID{a, b, c}, expected: []UUID{}},
+			{name: "nil elements", uuids: []UUID{a, NewUUIDFromPtr(nil), NewUUIDFromPtr(nil), NewUUIDUndefined(), NewUUIDUndefined()}, expected: []UUID{}},
+			{name: "nil and duplicates", uuids: []UUID{NewUUIDFromPtr(nil), c, NewUUIDFromPtr(nil), c}, expected: []UUID{c}},
+			{name: "empty", uuids: nil, expected: []UUID{}},
 		}
 
 		for _, tc := range tt {
-			t.Run(tc.input, func(t *testing.T) {
-				timestamp, err := TimestampFromString(tc.input)
+			t.Run(tc.name, func(t *testing.T) {
+				assert.Equal(t, tc.expected, DuplicateUUIDs(tc.uuids))
+				assert.Equal(t, len(tc.expected) > 0, HasDuplicateUUIDs(tc.uuids))
+			})
+		}
+	})
 
-				if tc.err != nil {
-					require.Error(t, err)
-					assert.Equal(t, tc.err.Error(), err.Error())
-					return
-				}
+	t.Run("UpsertByKey", func(t *testing.T) {
+		type record struct {
+			ID   UUID
+			Name string
+		}
 
-				require.NoError(t, err)
+		id1 := NewUUID(uuid.MustParse("11111111-1111-1111-1111-111111111111"))
+		id2 := NewUUID(uuid.MustParse("22222222-2222-2222-2222-222222222222"))
+		id3 := NewUUID(uuid.MustParse("33333333-3333-3333-3333-333333333333"))
 
-				assert.Equal(t, tc.expected, timestamp.String())
+		existing := []record{{id1, "one"}, {id2, "two"}}
+		incoming := []record{{id3, "three"}, {id1, "uno"}, {NewUUIDFromPtr(nil), "no key"}}
+
+		result := UpsertByKey(existing, incoming, func(r record) UUID { return r.ID })
+
+		assert.Equal(t, []record{
+			{id1, "uno"},
+			{id2, "two"},
+			{id3, "three"},
+			{NewUUIDFromPtr(nil), "no key"},
+		}, result)
+		assert.Equal(t, "one", existing[0].Name)
+
+		assert.Empty(t, UpsertByKey(nil, nil, func(r record) UUID { return r.ID }))
+	})
+
+	t.Run("RequireVersion", func(t *testing.T) {
+		v1 := "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+		v4 := "9b2e1c3a-5f0d-4c8e-9a7b-2d4f6e8a0c1b"
+
+		assert.NoError(t, NewUUID(uuid.MustParse(v4)).RequireVersion(4))
+		assert.EqualError(t, NewUUID(uuid.MustParse(v1)).RequireVersion(4), "uuid "+v1+" is version 1, expected version 4")
+		assert.NoError(t, NewUUID(uuid.MustParse(v1)).RequireVersion(1))
+		assert.EqualError(t, NewUUIDFromPtr(nil).RequireVersion(4), "uuid is nil")
+
+		u, err := UUIDFromStringV4Only(v4)
+		require.NoError(t, err)
+		assert.Equal(t, v4, u.String())
+
+		_, err = UUIDFromStringV4Only(v1)
+		assert.Error(t, err)
+
+		_, err = UUIDFromStringV4Only("not-a-uuid")
+		assert.Error(t, err)
+
+		u, err = UUIDFromStringV4Only("")
+		require.NoError(t, err)
+		assert.True(t, u.IsNil())
+	})
+
+	t.Run("UUIDsFromStringsE", func(t *testing.T) {
+		valid := []string{"123e4567-e89b-12d3-a456-426614174000", " 7d444840-9dc0-11d1-b245-5ffdce74fad2 "}
+
+		uuids, err := UUIDsFromStringsE(valid)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"123e4567-e89b-12d3-a456-426614174000", "7d444840-9dc0-11d1-b245-5ffdce74fad2"}, UUIDsToStrings(uuids))
+
+		tt := []struct {
+			name   string
+			input  []string
+			errMsg string
+		}{
+			{name: "invalid first", input: []string{"nope", valid[0]}, errMsg: "cannot parse element 0"},
+			{name: "invalid last", input: []string{valid[0], valid[1], "123e4567-e89b-12d3-a456"}, errMsg: "cannot parse element 2"},
+			{name: "only the first error", input: []string{valid[0], "x", "y"}, errMsg: "cannot parse element 1"},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				uuids, err := UUIDsFromStringsE(tc.input)
+				assert.ErrorContains(t, err, tc.errMsg)
+				assert.Nil(t, uuids)
 			})
 		}
+
+		assert.Panics(t, func() { UUIDsFromStrings([]string{valid[0], "nope"}) })
+	})
+
+	t.Run("IndexByUUID", func(t *testing.T) {
+		type record struct {
+			ID   UUID
+			Name string
+		}
+
+		first := NewRandomUUID()
+		second := NewRandomUUID()
+
+		records := []record{
+			{ID: first, Name: "a"},
+			{ID: second, Name: "b"},
+			{ID: NewUUIDFromPtr(nil), Name: "nil"},
+			{ID: NewUUIDUndefined(), Name: "undefined"},
+			{ID: first, Name: "c"},
+		}
+
+		index := IndexByUUID(records, func(r record) UUID { return r.ID })
+		require.Len(t, index, 2)
+		assert.Equal(t, "c", index[first].Name) // last wins
+		assert.Equal(t, "b", index[second].Name)
+
+		parsed, err := UUIDFromString(second.String())
+		require.NoError(t, err)
+		assert.Equal(t, "b", index[parsed].Name)
+
+		assert.Empty(t, IndexByUUID(nil, func(r record) UUID { return r.ID }))
+	})
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("all types are validators", func(t *testing.T) {
+		validators := []Validator{
+			Bool{}, Bytes{}, Color{}, Date{}, DateTime{}, Float64{}, Int{}, Int16{}, Int64{}, JSON{}, Money{},
+			Percent{}, RichText{}, String{}, Time{}, TimeSeconds{}, Timestamp{}, UUID{},
+		}
+
+		for _, v := range validators {
+			assert.NoError(t, v.Validate())
+			assert.EqualError(t, v.Validate(Required()), "value is required")
+		}
+	})
+
+	t.Run("WithMaxLength", func(t *testing.T) {
+		assert.NoError(t, NewString("åäö").Validate(WithMaxLength(3)))
+		assert.EqualError(t, NewString("åäöx").Validate(WithMaxLength(3)), "length 4 exceeds the max length 3")
+		assert.EqualError(t, NewRichText("<p>long</p>").Validate(WithMaxLength(3)), "length 4 exceeds the max length 3")
+		assert.NoError(t, NewRichText("<p><strong>åäö</strong></p>").Validate(WithMaxLength(3)))
+		assert.NoError(t, NewStringFromPtr(nil).Validate(WithMaxLength(3)))
+		assert.EqualError(t, NewStringUndefined().Validate(WithMaxLength(3), Required()), "value is required")
+		assert.EqualError(t, NewInt(1).Validate(WithMaxLength(3)), "max length rule is not supported for types.Int")
+	})
+
+	t.Run("WithRange", func(t *testing.T) {
+		assert.NoError(t, NewInt(1).Validate(WithRange(1, 10)))
+		assert.NoError(t, NewInt64(10).Validate(WithRange(1, 10)))
+		assert.EqualError(t, NewInt16(11).Validate(WithRange(1, 10)), "value 11 is not within the range 1 to 10")
+		assert.Error(t, NewFloat64(0.5).Validate(WithRange(1, 10)))
+		assert.NoError(t, NewIntFromPtr(nil).Validate(WithRange(1, 10)))
+		assert.Error(t, NewString("1").Validate(WithRange(1, 10)))
+	})
+
+	t.Run("WithNotInFuture", func(t *testing.T) {
+		now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+		SetNow(func() time.Time { return now })
+		t.Cleanup(func() { SetNow(nil) })
+
+		assert.NoError(t, NewDate(now).Validate(WithNotInFuture(time.UTC)))
+		assert.Error(t, NewDate(now.AddDate(0, 0, 1)).Validate(WithNotInFuture(time.UTC)))
+		assert.Error(t, NewTimestamp(now.Add(time.Minute)).Validate(WithNotInFuture(time.UTC)))
+	})
+
+	t.Run("custom rule", func(t *testing.T) {
+		notEmpty := NewRule(func(value any) error {
+			if value.(String).String() == "" {
+				return errors.New("must not be empty")
+			}
+
+			return nil
+		})
+
+		assert.NoError(t, NewString("a").Validate(Required(), notEmpty))
+		assert.EqualError(t, NewString("").Validate(Required(), notEmpty), "must not be empty")
+	})
+}
+
+func TestValidateStruct(t *testing.T) {
+	type address struct {
+		Street String `json:"street" types:"required"`
+	}
+
+	type audit struct {
+		CreatedBy String `json:"created_by" types:"required"`
+	}
+
+	type student struct {
+		audit
+		Name     String  `json:"name" types:"required,maxlength=5"`
+		Nickname String  `json:"nickname,omitempty" types:"pii,maxlength=3"`
+		Grade    Int     `json:"grade" types:"range=1:5"`
+		Weight   Percent `types:"range=0:100"`
+		Address  address `json:"address"`
+		Ignored  String  `json:"-" types:"required"`
+		Notes    string  `json:"notes"`
+	}
+
+	t.Run("multiple invalid fields", func(t *testing.T) {
+		messages, err := ValidateStruct(student{
+			Name:     NewString("Alexander"),
+			Nickname: NewString("Alex"),
+			Grade:    NewInt(7),
+			Weight:   NewPercent(50),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]string{
+			"created_by":     "value is required",
+			"name":           "length 9 exceeds the max length 5",
+			"nickname":       "length 4 exceeds the max length 3",
+			"grade":          "value 7 is not within the range 1 to 5",
+			"address.street": "value is required",
+		}, messages)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		messages, err := ValidateStruct(&student{
+			audit:   audit{CreatedBy: NewString("admin")},
+			Name:    NewString("Alice"),
+			Grade:   NewIntFromPtr(nil),
+			Address: address{Street: NewString("Storgatan 1")},
+		})
+		require.NoError(t, err)
+
+		assert.NotNil(t, messages)
+		assert.Empty(t, messages)
+	})
+
+	t.Run("programming errors", func(t *testing.T) {
+		_, err := ValidateStruct("student")
+		assert.EqualError(t, err, "types: ValidateStruct expects a struct, got string")
+
+		_, err = ValidateStruct(42)
+		assert.Error(t, err)
+
+		_, err = ValidateStruct(struct {
+			Name String `types:"maxlength=many"`
+		}{})
+		assert.EqualError(t, err, `types: invalid maxlength "many" for field Name`)
+
+		_, err = ValidateStruct(struct {
+			Grade Int `types:"range=1"`
+		}{})
+		assert.Error(t, err)
+
+		_, err = ValidateStruct(struct {
+			Address struct {
+				Street String `types:"requried"`
+			}
+		}{})
+		assert.EqualError(t, err, `types: unknown option "requried" for field Street`)
+	})
+
+	t.Run("pointer fields", func(t *testing.T) {
+		type guardian struct {
+			Name  *String `json:"name" types:"required"`
+			Email *String `json:"email" types:"maxlength=5"`
+			Phone *String `json:"phone" types:"maxlength=5"`
+		}
+
+		messages, err := ValidateStruct(guardian{Phone: NewString("070").Ptr()})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"name": "value is required",
+		}, messages)
+
+		messages, err = ValidateStruct(guardian{Name: NewString("Bob").Ptr(), Phone: NewString("070-123 45 67").Ptr()})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"phone": "length 13 exceeds the max length 5",
+		}, messages)
+	})
+}
+
+func TestYAML(t *testing.T) {
+	type Config struct {
+		Enabled   Bool      `yaml:"enabled"`
+		StartDate Date      `yaml:"start_date"`
+		Ratio     Float64   `yaml:"ratio"`
+		Count     Int       `yaml:"count"`
+		Small     Int16     `yaml:"small"`
+		Big       Int64     `yaml:"big"`
+		Settings  JSON      `yaml:"settings"`
+		Notes     RichText  `yaml:"notes"`
+		Name      String    `yaml:"name"`
+		Nickname  String    `yaml:"nickname"`
+		Address   String    `yaml:"address,omitempty"`
+		OpensAt   Time      `yaml:"opens_at"`
+		CreatedAt Timestamp `yaml:"created_at"`
+		ID        UUID      `yaml:"id"`
+		ParentID  *UUID     `yaml:"parent_id,omitempty"`
+	}
+
+	createdAt, err := TimestampFromString("2023-12-25T15:04:05Z")
+	require.NoError(t, err)
+
+	startDate, err := DateFromString("2023-12-25")
+	require.NoError(t, err)
+
+	opensAt, err := TimeFromString("08:30")
+	require.NoError(t, err)
+
+	id, err := UUIDFromString("123e4567-e89b-12d3-a456-426614174000")
+	require.NoError(t, err)
+
+	input := Config{
+		Enabled:   NewBool(true),
+		StartDate: startDate,
+		Ratio:     NewFloat64(1.5),
+		Count:     NewInt(42),
+		Small:     NewInt16(7),
+		Big:       NewInt64(1 << 40),
+		Settings:  NewJSON(json.RawMessage(`{"a":1}`)),
+		Notes:     NewRichText("<p>Hello</p>"),
+		Name:      NewString("John"),
+		Nickname:  NewStringFromPtr(nil),
+		Address:   NewStringUndefined(),
+		OpensAt:   opensAt,
+		CreatedAt: createdAt,
+		ID:        id,
+		ParentID:  NewUUIDUndefined().Ptr(),
+	}
+
+	yamlBytes, err := yaml.Marshal(input)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(yamlBytes), "nickname: null\n")
+	assert.NotContains(t, string(yamlBytes), "address")
+	assert.NotContains(t, string(yamlBytes), "parent_id")
+
+	var output Config
+	err = yaml.Unmarshal(yamlBytes, &output)
+	require.NoError(t, err)
+
+	assert.Equal(t, input.Enabled, output.Enabled)
+	assert.Equal(t, input.StartDate, output.StartDate)
+	assert.Equal(t, input.Ratio, output.Ratio)
+	assert.Equal(t, input.Count, output.Count)
+	assert.Equal(t, input.Small, output.Small)
+	assert.Equal(t, input.Big, output.Big)
+	assert.JSONEq(t, input.Settings.String(), output.Settings.String())
+	assert.Equal(t, input.Notes, output.Notes)
+	assert.Equal(t, input.Name, output.Name)
+	assert.Equal(t, input.OpensAt, output.OpensAt)
+	assert.Equal(t, input.CreatedAt, output.CreatedAt)
+	assert.Equal(t, input.ID, output.ID)
+
+	// yaml.v3 doesn't call UnmarshalYAML for null values, so nil comes back as undefined.
+	assert.True(t, output.Nickname.IsNil())
+	assert.False(t, output.Nickname.IsDefined())
+
+	var nulls struct {
+		Name  String `yaml:"name"`
+		Count Int    `yaml:"count"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte("name: ~\ncount: null\n"), &nulls))
+	assert.False(t, nulls.Name.IsDefined())
+	assert.False(t, nulls.Count.IsDefined())
+	assert.False(t, output.Address.IsDefined())
+	assert.Nil(t, output.ParentID)
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	now := time.Date(2024, 6, 1, 12, 34, 56, 0, time.UTC)
+
+	benchmarks := []struct {
+		name  string
+		value json.Marshaler
+	}{
+		{"Date", NewDate(now)},
+		{"Time", NewTime(now)},
+		{"Timestamp", NewTimestamp(now)},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				_, _ = bm.value.MarshalJSON()
+			}
+		})
+	}
+
+	// The previous implementation, formatting into a string and quoting it with json.Marshal, for comparison
+	b.Run("Timestamp with json.Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			_, _ = json.Marshal(now.Format("2006-01-02T15:04:05Z07:00"))
+		}
 	})
 }